@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"my-project/domain/dto"
+	"my-project/infrastructure/backup"
+	"my-project/infrastructure/clients/oidc"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/doctor"
+	"my-project/infrastructure/persistence"
+	"my-project/usecase"
+	"os"
+	"time"
+)
+
+// RunCommand executes a CLI subcommand and returns the process exit code.
+// It reports false when args do not name a subcommand so main starts the
+// HTTP server instead.
+func RunCommand(args []string) (int, bool) {
+	if len(args) == 0 {
+		return 0, false
+	}
+
+	var err error
+	switch args[0] {
+	case "backup":
+		err = runBackup(args[1:])
+	case "restore":
+		err = runRestore(args[1:])
+	case "seed":
+		err = runSeed(args[1:])
+	case "doctor":
+		err = runDoctor(args[1:])
+	default:
+		return 0, false
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", args[0], err)
+		return 1, true
+	}
+	return 0, true
+}
+
+func runBackup(args []string) error {
+	flags := flag.NewFlagSet("backup", flag.ContinueOnError)
+	out := flags.String("out", fmt.Sprintf("backup-%s.json", time.Now().UTC().Format("20060102150405")), "archive file to write")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	key, err := backupKey()
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return fmt.Errorf("%s is required to encrypt secret columns", backupKeyEnv)
+	}
+
+	db, err := persistence.NewPostgreSQLDb()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// The archive holds every user's data; never overwrite an existing file
+	// or leave it readable by other users.
+	file, err := os.OpenFile(*out, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := backup.Dump(context.Background(), db, file, key); err != nil {
+		file.Close()
+		os.Remove(*out)
+		return err
+	}
+	fmt.Printf("Backup written to %s\n", *out)
+	return file.Close()
+}
+
+// backupKeyEnv names the variable holding the base64 encoded archive key,
+// for example the output of `openssl rand -base64 32`.
+const backupKeyEnv = "BACKUP_KEY"
+
+// backupKey returns the archive key, or nil when the variable is not set.
+func backupKey() ([]byte, error) {
+	encoded := os.Getenv(backupKeyEnv)
+	if encoded == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != backup.KeySize {
+		return nil, fmt.Errorf("%s must be %d base64 encoded bytes", backupKeyEnv, backup.KeySize)
+	}
+	return key, nil
+}
+
+func runRestore(args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	in := flags.String("file", "", "archive file to restore")
+	truncate := flags.Bool("truncate", false, "remove existing rows before restoring")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" {
+		return fmt.Errorf("-file is required")
+	}
+	key, err := backupKey()
+	if err != nil {
+		return err
+	}
+
+	db, err := persistence.NewPostgreSQLDb()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	file, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := backup.Restore(context.Background(), db, file, *truncate, key); err != nil {
+		return err
+	}
+	fmt.Printf("Backup restored from %s\n", *in)
+	return nil
+}
+
+func runSeed(args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	in := flags.String("file", "fixtures/demo.json", "fixture file to load")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+	var fixtures dto.Fixtures
+	if err := json.Unmarshal(content, &fixtures); err != nil {
+		return fmt.Errorf("decode %s: %w", *in, err)
+	}
+
+	db, err := persistence.NewPostgreSQLDb()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	seedUsecase := usecase.NewSeedUsecase(persistence.NewUserRepository(db), persistence.NewSeedRepository(db))
+	res := seedUsecase.Seed(context.Background(), fixtures)
+	if res.ResponseCode != "200" {
+		return fmt.Errorf("%s", res.ResponseMessage)
+	}
+	result := res.Data.(dto.SeedResult)
+	fmt.Printf("Seeded %d users, skipped %d existing\n", result.UsersCreated, len(result.UsersSkipped))
+	return nil
+}
+
+func runDoctor(args []string) error {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	changelog := flags.String("changelog", "liquibase/my_project_postgres/sql/my-project-changelog.sql", "liquibase changelog to compare the schema with")
+	timeout := flags.Duration("timeout", 5*time.Second, "time limit for each check")
+	noColor := flags.Bool("no-color", os.Getenv("NO_COLOR") != "", "print the report without colors")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	db, dbErr := persistence.NewPostgreSQLDb()
+	if dbErr == nil {
+		defer db.Close()
+	}
+
+	results := doctor.Run(context.Background(), []doctor.Check{
+		doctor.Config(configuration.C),
+		doctor.Database(db, dbErr, *changelog),
+		doctor.Redis(configuration.C.RedisClient),
+		doctor.OIDC(oidc.NewProviders(configuration.C.OIDC)),
+		doctor.Upstream("tulus tech", configuration.C.TulusTech.Host),
+		doctor.Egress(configuration.C.Egress),
+	}, *timeout)
+	doctor.Report(os.Stdout, results, !*noColor)
+
+	if doctor.Failed(results) {
+		return fmt.Errorf("some checks failed")
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "my-project/domain/model"
+
+// CreatedAccessToken carries the token itself. It is only returned once, when
+// the token is created.
+type CreatedAccessToken struct {
+	model.AccessToken
+	Token string `json:"token"`
+}
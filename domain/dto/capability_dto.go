@@ -0,0 +1,11 @@
+package dto
+
+type Capabilities struct {
+	Database bool            `json:"database"`
+	ReadOnly bool            `json:"read_only"`
+	Features map[string]bool `json:"features"`
+	// CaptchaSiteKey is the public key the frontend renders the widget with.
+	CaptchaSiteKey string `json:"captcha_site_key,omitempty"`
+	// CacheTTLSeconds is the effective lifetime of each cache entity.
+	CacheTTLSeconds map[string]int64 `json:"cache_ttl_seconds"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"my-project/domain/model"
+	"time"
+)
+
+const (
+	ExportStatusPending   = "pending"
+	ExportStatusRunning   = "running"
+	ExportStatusCompleted = "completed"
+	ExportStatusFailed    = "failed"
+)
+
+type ExportStatus struct {
+	Status      string     `json:"status"`
+	Progress    int        `json:"progress"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+type ExportProfile struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	UserName  string    `json:"user_name"`
+	Email     string    `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AccountExport struct {
+	ExportedAt    time.Time             `json:"exported_at"`
+	Profile       ExportProfile         `json:"profile"`
+	SearchHistory []model.SearchHistory `json:"search_history"`
+	SavedSearches []model.SavedSearch   `json:"saved_searches"`
+	Bookmarks     []model.VideoBookmark `json:"bookmarks"`
+	Notes         []model.VideoNote     `json:"notes"`
+	Sessions      []model.UserSession   `json:"sessions"`
+	Identities    []model.UserIdentity  `json:"identities"`
+	// AccessTokens carries token metadata only; the token hash is never
+	// serialised.
+	AccessTokens []model.AccessToken `json:"access_tokens"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"my-project/domain/model"
+	"testing"
+	"time"
+)
+
+func BenchmarkAccountExport_Marshal(b *testing.B) {
+	now := time.Now()
+	export := AccountExport{
+		ExportedAt: now,
+		Profile:    ExportProfile{ID: 1, Name: "Lambok Tulus Simamora", UserName: "lamboktulus1379", CreatedAt: now, UpdatedAt: now},
+	}
+	for i := 0; i < 10000; i++ {
+		id := int64(i)
+		export.SearchHistory = append(export.SearchHistory, model.SearchHistory{ID: id, UserID: 1, Query: fmt.Sprintf("golang tutorial %d", i), CreatedAt: now})
+		export.Bookmarks = append(export.Bookmarks, model.VideoBookmark{ID: id, UserID: 1, VideoID: fmt.Sprintf("video%07d", i), CreatedAt: now})
+		export.Notes = append(export.Notes, model.VideoNote{ID: id, VideoID: "dQw4w9WgXcQ", UserID: 1, Body: "Add chapters for the intro and the outro", CreatedAt: now, UpdatedAt: now})
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(export); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
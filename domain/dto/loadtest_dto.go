@@ -0,0 +1,12 @@
+package dto
+
+// LoadTestRequest matches the object form accepted by k6's http.batch.
+type LoadTestRequest struct {
+	Method string         `json:"method"`
+	URL    string         `json:"url"`
+	Params LoadTestParams `json:"params"`
+}
+
+type LoadTestParams struct {
+	Headers map[string]string `json:"headers"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type RuntimeStats struct {
+	StartedAt  time.Time  `json:"started_at"`
+	Uptime     string     `json:"uptime"`
+	Goroutines int        `json:"goroutines"`
+	CPUs       int        `json:"cpus"`
+	Memory     MemStats   `json:"memory"`
+	GC         GCStats    `json:"gc"`
+	Build      BuildStats `json:"build"`
+}
+
+type MemStats struct {
+	HeapAllocBytes uint64 `json:"heap_alloc_bytes"`
+	HeapInuseBytes uint64 `json:"heap_inuse_bytes"`
+	HeapObjects    uint64 `json:"heap_objects"`
+	SysBytes       uint64 `json:"sys_bytes"`
+}
+
+type GCStats struct {
+	NumGC       uint32     `json:"num_gc"`
+	LastGC      *time.Time `json:"last_gc,omitempty"`
+	PauseTotal  string     `json:"pause_total"`
+	LastPause   string     `json:"last_pause"`
+	CPUFraction float64    `json:"cpu_fraction"`
+	NextGCBytes uint64     `json:"next_gc_bytes"`
+}
+
+type BuildStats struct {
+	GoVersion string            `json:"go_version"`
+	Path      string            `json:"path"`
+	Version   string            `json:"version"`
+	Settings  map[string]string `json:"settings,omitempty"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "my-project/domain/model"
+
+type Fixtures struct {
+	Users []FixtureUser `json:"users"`
+}
+
+type FixtureUser struct {
+	Name          string                 `json:"name"`
+	UserName      string                 `json:"user_name"`
+	Password      string                 `json:"password"`
+	SavedSearches []model.ReqSavedSearch `json:"saved_searches"`
+	Bookmarks     []string               `json:"bookmarks"`
+	Notes         []FixtureNote          `json:"notes"`
+}
+
+type FixtureNote struct {
+	VideoID string `json:"video_id"`
+	Body    string `json:"body"`
+}
+
+type SeedResult struct {
+	UsersCreated int      `json:"users_created"`
+	UsersSkipped []string `json:"users_skipped"`
+}
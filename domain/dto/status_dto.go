@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"my-project/domain/model"
+	"time"
+)
+
+const (
+	StatusOperational = "operational"
+	StatusDegraded    = "degraded"
+	StatusMajorOutage = "major_outage"
+)
+
+// Status is the public summary served on /status.
+type Status struct {
+	Status        string            `json:"status"`
+	Components    []ComponentStatus `json:"components"`
+	Incidents     []model.Incident  `json:"incidents"`
+	StartedAt     time.Time         `json:"started_at"`
+	UptimeSeconds int64             `json:"uptime_seconds"`
+}
+
+type ComponentStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// UserUsage summarises a user's API requests since a day (UTC). Requests are
+// counted in the cache first and show up here once they have been flushed.
+type UserUsage struct {
+	UserID    int64            `json:"user_id"`
+	Since     time.Time        `json:"since"`
+	Total     int64            `json:"total"`
+	Endpoints map[string]int64 `json:"endpoints"`
+	Days      []DailyUsage     `json:"days,omitempty"`
+}
+
+type DailyUsage struct {
+	Day   string `json:"day"`
+	Count int64  `json:"count"`
+}
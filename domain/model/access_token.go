@@ -0,0 +1,64 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// AccessTokenPrefix marks personal access tokens so the auth middleware can
+// tell them apart from session JWTs.
+const AccessTokenPrefix = "pat_"
+
+const (
+	ScopeReadSearches   = "read:searches"
+	ScopeWriteSearches  = "write:searches"
+	ScopeReadBookmarks  = "read:bookmarks"
+	ScopeWriteBookmarks = "write:bookmarks"
+	ScopeReadNotes      = "read:notes"
+	ScopeWriteNotes     = "write:notes"
+	ScopeReadUsage      = "read:usage"
+)
+
+// Scopes lists every scope a personal access token can be granted.
+var Scopes = []string{
+	ScopeReadSearches, ScopeWriteSearches,
+	ScopeReadBookmarks, ScopeWriteBookmarks,
+	ScopeReadNotes, ScopeWriteNotes,
+	ScopeReadUsage,
+}
+
+// AccessToken is a personal access token. Only the SHA-256 hash of the token
+// is stored; Prefix keeps its first characters so users can recognise it.
+type AccessToken struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	TokenHash  string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+func (token AccessToken) HasScope(scope string) bool {
+	for _, granted := range token.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HashAccessToken returns the value stored for a token in place of the token.
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type ReqAccessToken struct {
+	Name          string   `json:"name" binding:"required,max=255"`
+	Scopes        []string `json:"scopes" binding:"required,min=1"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+}
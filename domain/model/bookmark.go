@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type VideoBookmark struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	VideoID   string    `json:"video_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// EmailVerificationLifetime is how long a confirmation link stays valid.
+const EmailVerificationLifetime = 24 * time.Hour
+
+// EmailVerification is an address a user entered that has not been confirmed
+// yet. Until it is, the address is not stored on the user. Only the SHA-256
+// hash of the token is kept.
+type EmailVerification struct {
+	ID        int64
+	UserID    int64
+	Email     string
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// HashVerificationToken returns the value stored for a token in place of the
+// token.
+func HashVerificationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
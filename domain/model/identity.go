@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// UserIdentity links an account to the subject an OIDC provider knows the
+// user by.
+type UserIdentity struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
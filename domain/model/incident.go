@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+const (
+	IncidentMinor       = "minor"
+	IncidentMajor       = "major"
+	IncidentMaintenance = "maintenance"
+)
+
+// Incident is an admin-managed marker shown on the public status page. It is
+// open until ResolvedAt is set.
+type Incident struct {
+	ID         int64      `json:"id"`
+	Title      string     `json:"title"`
+	Message    string     `json:"message"`
+	Severity   string     `json:"severity"`
+	StartedAt  time.Time  `json:"started_at"`
+	ResolvedAt *time.Time `json:"resolved_at"`
+}
+
+type ReqIncident struct {
+	Title    string `json:"title" binding:"required,max=255"`
+	Message  string `json:"message"`
+	Severity string `json:"severity" binding:"omitempty,oneof=minor major maintenance"`
+}
@@ -1,12 +1,27 @@
 package model
 
 type ReqLogin struct {
-	UserName string `json:"user_name" binding:"required"`
-	Password string `json:"password" binding:"required"`
+	UserName string        `json:"user_name" binding:"required"`
+	Password string        `json:"password" binding:"required"`
+	Client   SessionClient `json:"-"`
 }
 
 type ReqRegister struct {
-	Name     string `json:"name" binding:"required"`
-	UserName string `json:"user_name" binding:"required"`
+	Name string `json:"name" binding:"required"`
+	// UserName may not contain ":", which is reserved for accounts created
+	// by an OIDC provider.
+	UserName string `json:"user_name" binding:"required,excludes=:"`
 	Password string `json:"password" binding:"required"`
+	Email    string `json:"email" binding:"omitempty,email"`
+}
+
+// OIDCIdentity is a user signed in by a provider. Accounts are matched by
+// Provider and Subject only; Email is a claim that is trusted only when
+// EmailVerified is set.
+type OIDCIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
 }
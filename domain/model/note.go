@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+type VideoNote struct {
+	ID        int64     `json:"id"`
+	VideoID   string    `json:"video_id"`
+	UserID    int64     `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReqVideoNote struct {
+	Body string `json:"body" binding:"required"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type SearchHistory struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Query     string    `json:"query"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SavedSearch struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Name      string    `json:"name"`
+	Query     string    `json:"query"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ReqSearchHistory struct {
+	Query string `json:"query" binding:"required"`
+}
+
+type ReqSavedSearch struct {
+	Name  string `json:"name" binding:"required,max=100"`
+	Query string `json:"query" binding:"required,max=255"`
+}
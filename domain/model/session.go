@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type UserSession struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+// SessionTokenLifetime is how long an access token issued at login stays
+// valid. A session revocation has to be remembered for at least this long.
+const SessionTokenLifetime = 5 * time.Minute
+
+// SessionClient describes the device a login request came from.
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
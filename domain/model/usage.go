@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// APIUsage counts the requests a user made to one endpoint on one day (UTC).
+// Endpoint is the method and route template, e.g. "GET /api/bookmarks".
+type APIUsage struct {
+	UserID   int64     `json:"user_id"`
+	Day      time.Time `json:"day"`
+	Endpoint string    `json:"endpoint"`
+	Count    int64     `json:"count"`
+}
@@ -6,19 +6,46 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
+const (
+	UserStatusActive   = "active"
+	UserStatusPending  = "pending"
+	UserStatusRejected = "rejected"
+	// UserStatusUnverified accounts wait for their email address to be
+	// confirmed before the registration domain lists are applied.
+	UserStatusUnverified = "unverified"
+)
+
 type User struct {
 	ID        int64     `gorm:"primaryKey;column:id;type:bigint(20);not null" json:"id"`
 	Name      string    `gorm:"column:name;type:varchar(45)"`
 	UserName  string    `gorm:"column:user_name;type:varchar(45)"`
 	Password  string    `gorm:"column:password;type:varchar(225)"`
 	Email     string    `json:"email"`
+	Status    string    `json:"status"`
 	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime;type:datetime;not null;default:CURRENT_TIMESTAMP"`
 	CreatedBy int64     `gorm:"column:created_by;type:varchar(225);not null" json:"created_by,omitempty"`
 	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime;type:datetime;not null;default:CURRENT_TIMESTAMP"`
 	UpdatedBy int64     `gorm:"column:updated_by;type:int;not null" json:"updated_at,omitempty"`
 }
 
+// InactiveReason explains why the account may not sign in or use its tokens,
+// or returns an empty string for active accounts.
+func (user User) InactiveReason() string {
+	switch user.Status {
+	case UserStatusActive:
+		return ""
+	case UserStatusPending:
+		return "Account is pending approval."
+	case UserStatusRejected:
+		return "Account registration was rejected."
+	case UserStatusUnverified:
+		return "Email address has not been confirmed yet."
+	}
+	return "Account is not active."
+}
+
 type UserClaims struct {
-	UserName string `json:"user_name"`
+	UserName  string `json:"user_name"`
+	SessionID int64  `json:"sid"`
 	jwt.StandardClaims
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"my-project/domain/model"
+)
+
+type IAccessToken interface {
+	CreateToken(ctx context.Context, token model.AccessToken) (model.AccessToken, error)
+	GetTokensByUserId(ctx context.Context, userId int64) ([]model.AccessToken, error)
+	GetTokenByHash(ctx context.Context, tokenHash string) (model.AccessToken, error)
+	TouchToken(ctx context.Context, id int64) error
+	RevokeToken(ctx context.Context, userId int64, id int64) error
+}
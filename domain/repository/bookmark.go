@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"my-project/domain/model"
+)
+
+type IBookmark interface {
+	CreateBookmark(ctx context.Context, bookmark model.VideoBookmark) error
+	DeleteBookmark(ctx context.Context, userId int64, videoId string) error
+	GetBookmarksByUserId(ctx context.Context, userId int64) ([]model.VideoBookmark, error)
+}
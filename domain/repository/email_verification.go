@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"my-project/domain/model"
+)
+
+type IEmailVerification interface {
+	CreateUserWithVerification(ctx context.Context, user model.User, verification model.EmailVerification) (int64, error)
+	GetVerificationByHash(ctx context.Context, tokenHash string) (model.EmailVerification, error)
+	ConfirmEmail(ctx context.Context, verification model.EmailVerification) error
+}
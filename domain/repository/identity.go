@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"my-project/domain/model"
+)
+
+type IIdentity interface {
+	GetUserByIdentity(ctx context.Context, provider string, subject string) (model.User, error)
+	CreateUserWithIdentity(ctx context.Context, user model.User, provider string, subject string) (int64, error)
+	GetIdentitiesByUserId(ctx context.Context, userId int64) ([]model.UserIdentity, error)
+	// LinkIdentity returns sql.ErrNoRows when the subject is already linked.
+	LinkIdentity(ctx context.Context, userId int64, provider string, subject string) error
+}
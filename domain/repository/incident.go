@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"my-project/domain/model"
+)
+
+type IIncident interface {
+	CreateIncident(ctx context.Context, incident model.Incident) (int64, error)
+	GetIncidentsSince(ctx context.Context, since time.Time) ([]model.Incident, error)
+	ResolveIncident(ctx context.Context, id int64) error
+}
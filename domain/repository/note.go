@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"my-project/domain/model"
+)
+
+type INote interface {
+	CreateNote(ctx context.Context, note model.VideoNote) (model.VideoNote, error)
+	GetNotesByVideoId(ctx context.Context, userId int64, videoId string) ([]model.VideoNote, error)
+	GetNotesByUserId(ctx context.Context, userId int64) ([]model.VideoNote, error)
+	UpdateNote(ctx context.Context, note model.VideoNote) (model.VideoNote, error)
+	DeleteNote(ctx context.Context, userId int64, videoId string, id int64) error
+}
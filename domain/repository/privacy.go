@@ -0,0 +1,9 @@
+package repository
+
+import (
+	"context"
+)
+
+type IPrivacy interface {
+	PurgeUserData(ctx context.Context, userId int64) error
+}
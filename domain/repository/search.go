@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"my-project/domain/model"
+)
+
+type ISearch interface {
+	CreateHistory(ctx context.Context, history model.SearchHistory) error
+	GetHistoryByUserId(ctx context.Context, userId int64, limit int) ([]model.SearchHistory, error)
+	DeleteHistory(ctx context.Context, userId int64, id int64) error
+	DeleteAllHistory(ctx context.Context, userId int64) error
+	DeleteHistoryBefore(ctx context.Context, before time.Time) (int64, error)
+	// CreateSavedSearch returns sql.ErrNoRows when the user already has a
+	// saved search with the same name.
+	CreateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (int64, error)
+	GetSavedSearchesByUserId(ctx context.Context, userId int64) ([]model.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userId int64, id int64) error
+}
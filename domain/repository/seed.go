@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"my-project/domain/model"
+)
+
+type ISeed interface {
+	// CreateUserWithData stores a user and its data in one transaction and
+	// returns the user id.
+	CreateUserWithData(ctx context.Context, user model.User, savedSearches []model.SavedSearch, bookmarks []model.VideoBookmark, notes []model.VideoNote) (int64, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"my-project/domain/model"
+)
+
+type ISession interface {
+	CreateSession(ctx context.Context, session model.UserSession) (int64, error)
+	GetSessionsByUserId(ctx context.Context, userId int64) ([]model.UserSession, error)
+	// IsSessionRevoked reports a missing session as revoked.
+	IsSessionRevoked(ctx context.Context, id int64) (bool, error)
+	TouchSession(ctx context.Context, id int64) error
+	RevokeSession(ctx context.Context, userId int64, id int64) error
+	DeleteSessionsBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"my-project/domain/model"
+)
+
+type IUsage interface {
+	AddUsage(ctx context.Context, usages []model.APIUsage) error
+	GetUsageByUserId(ctx context.Context, userId int64, since time.Time) ([]model.APIUsage, error)
+	GetUsageTotals(ctx context.Context, since time.Time) ([]model.APIUsage, error)
+}
@@ -9,5 +9,8 @@ import (
 type IUser interface {
 	GetById(ctx context.Context, id int) (model.User, error)
 	GetByUserName(ctx context.Context, userName string) (model.User, error)
+	GetByEmail(ctx context.Context, email string) (model.User, error)
 	CreateUser(ctx context.Context, user model.User) error
+	GetByStatus(ctx context.Context, status string) ([]model.User, error)
+	UpdateStatus(ctx context.Context, id int64, from string, to string) error
 }
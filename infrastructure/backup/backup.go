@@ -0,0 +1,218 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"my-project/infrastructure/logger"
+	"strings"
+	"time"
+)
+
+// archiveVersion 2 added encrypted secret columns. Version 1 archives are
+// still restored.
+const archiveVersion = 2
+
+// Tables lists the application tables in dependency order: parents first so
+// a restore can insert them in sequence without violating foreign keys.
+var Tables = []string{
+	"public.user",
+	"public.search_history",
+	"public.saved_search",
+	"public.user_video_bookmarks",
+	"public.video_notes",
+	"public.user_sessions",
+	"public.status_incidents",
+	"public.api_usage",
+	"public.personal_access_tokens",
+	"public.user_identities",
+	"public.email_verifications",
+}
+
+type Archive struct {
+	Version   int              `json:"version"`
+	CreatedAt time.Time        `json:"created_at"`
+	Tables    map[string]Table `json:"tables"`
+}
+
+type Table struct {
+	Columns []string        `json:"columns"`
+	Rows    [][]interface{} `json:"rows"`
+	// Encrypted lists the columns whose values are encrypted with the
+	// backup key.
+	Encrypted []string `json:"encrypted,omitempty"`
+}
+
+// Dump writes every application table to w as a JSON archive. The tables are
+// read in one repeatable read transaction so the archive is a consistent
+// snapshot even while users are active. SecretColumns are encrypted with key.
+func Dump(ctx context.Context, db *sql.DB, w io.Writer, key []byte) error {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	archive := Archive{
+		Version:   archiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Tables:    make(map[string]Table, len(Tables)),
+	}
+
+	for _, name := range Tables {
+		table, err := dumpTable(ctx, tx, name)
+		if err != nil {
+			return fmt.Errorf("dump %s: %w", name, err)
+		}
+		if err := encryptTable(aead, name, &table); err != nil {
+			return err
+		}
+		archive.Tables[name] = table
+		logger.GetLogger().WithField("table", name).WithField("rows", len(table.Rows)).Info("Table dumped")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(archive)
+}
+
+func dumpTable(ctx context.Context, tx *sql.Tx, name string) (Table, error) {
+	table := Table{Rows: [][]interface{}{}}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %s", name))
+	if err != nil {
+		return table, err
+	}
+	defer rows.Close()
+
+	table.Columns, err = rows.Columns()
+	if err != nil {
+		return table, err
+	}
+
+	for rows.Next() {
+		values := make([]interface{}, len(table.Columns))
+		pointers := make([]interface{}, len(table.Columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return table, err
+		}
+		for i, value := range values {
+			if b, ok := value.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		table.Rows = append(table.Rows, values)
+	}
+
+	return table, rows.Err()
+}
+
+// Restore loads an archive written by Dump in a single transaction. Tables
+// must be empty unless truncate is set, in which case existing rows are
+// removed first. The truncate does not cascade: a table referencing one in
+// Tables without being listed itself makes the restore fail instead of being
+// emptied without a backup. key is only needed for archives with encrypted
+// columns.
+func Restore(ctx context.Context, db *sql.DB, r io.Reader, truncate bool, key []byte) error {
+	var archive Archive
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := decoder.Decode(&archive); err != nil {
+		return fmt.Errorf("decode archive: %w", err)
+	}
+	if archive.Version != 1 && archive.Version != archiveVersion {
+		return fmt.Errorf("unsupported archive version %d", archive.Version)
+	}
+	for name, table := range archive.Tables {
+		if len(table.Encrypted) == 0 {
+			continue
+		}
+		if len(key) == 0 {
+			return ErrKeyRequired
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return err
+		}
+		if err := decryptTable(aead, name, &table); err != nil {
+			return err
+		}
+		archive.Tables[name] = table
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if truncate {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("TRUNCATE %s RESTART IDENTITY", strings.Join(Tables, ", "))); err != nil {
+			return fmt.Errorf("truncate: %w", err)
+		}
+	}
+
+	for _, name := range Tables {
+		table, ok := archive.Tables[name]
+		if !ok {
+			continue
+		}
+		if err := restoreTable(ctx, tx, name, table); err != nil {
+			return fmt.Errorf("restore %s: %w", name, err)
+		}
+		logger.GetLogger().WithField("table", name).WithField("rows", len(table.Rows)).Info("Table restored")
+	}
+
+	return tx.Commit()
+}
+
+func restoreTable(ctx context.Context, tx *sql.Tx, name string, table Table) error {
+	var count int
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s", name)).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("table is not empty (%d rows), rerun with truncate", count)
+	}
+	if len(table.Rows) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(table.Columns))
+	for i := range placeholders {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	statement, err := tx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE VALUES (%s)",
+		name, strings.Join(table.Columns, ", "), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return err
+	}
+	defer statement.Close()
+
+	for _, row := range table.Rows {
+		if _, err := statement.ExecContext(ctx, row...); err != nil {
+			return err
+		}
+	}
+
+	if columnIndex(table.Columns, "id") < 0 {
+		return nil
+	}
+	// Identity columns keep their own counters; move them past the restored ids.
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%s', 'id'), (SELECT MAX(id) FROM %s))", name, name))
+	return err
+}
@@ -0,0 +1,123 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = bytes.Repeat([]byte{7}, KeySize)
+
+func TestDump(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2023, 9, 4, 1, 2, 10, 0, time.UTC)
+	mock.ExpectBegin()
+	for _, name := range Tables {
+		rows := sqlmock.NewRows([]string{"id", "name", "created_at"})
+		if name == "public.user" {
+			rows = sqlmock.NewRows([]string{"id", "name", "password", "created_at"}).
+				AddRow(1, []byte("Lambok Tulus Simamora"), []byte("5f4dcc3b5aa765d61d8327deb882cf99"), createdAt)
+		}
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM " + name)).WillReturnRows(rows)
+	}
+	mock.ExpectCommit()
+
+	var buf bytes.Buffer
+	err = Dump(context.Background(), db, &buf, testKey)
+	require.NoError(t, err)
+
+	var archive Archive
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &archive))
+	require.Len(t, archive.Tables, len(Tables))
+	require.Equal(t, []string{"id", "name", "password", "created_at"}, archive.Tables["public.user"].Columns)
+	require.Equal(t, "Lambok Tulus Simamora", archive.Tables["public.user"].Rows[0][1])
+	require.Equal(t, []string{"password"}, archive.Tables["public.user"].Encrypted)
+	require.NotContains(t, buf.String(), "5f4dcc3b5aa765d61d8327deb882cf99")
+	require.Empty(t, archive.Tables["public.video_notes"].Rows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreRejectsNonEmptyTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM public.user")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectRollback()
+
+	archive := `{"version": 1, "tables": {"public.user": {"columns": ["id"], "rows": [[1]]}}}`
+	err = Restore(context.Background(), db, strings.NewReader(archive), false, nil)
+
+	require.ErrorContains(t, err, "not empty")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRestoreRejectsUnknownVersion(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = Restore(context.Background(), db, strings.NewReader(`{"version": 99}`), false, nil)
+
+	require.ErrorContains(t, err, "unsupported archive version")
+}
+
+func TestRestoreDecryptsSecretColumns(t *testing.T) {
+	table := Table{Columns: []string{"id", "password"}, Rows: [][]interface{}{{1, "5f4dcc3b5aa765d61d8327deb882cf99"}}}
+	aead, err := newAEAD(testKey)
+	require.NoError(t, err)
+	require.NoError(t, encryptTable(aead, "public.user", &table))
+	var buf bytes.Buffer
+	require.NoError(t, json.NewEncoder(&buf).Encode(Archive{Version: archiveVersion, Tables: map[string]Table{"public.user": table}}))
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM public.user")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectPrepare(regexp.QuoteMeta("INSERT INTO public.user (id, password)")).
+		ExpectExec().WithArgs(sqlmock.AnyArg(), "5f4dcc3b5aa765d61d8327deb882cf99").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("SELECT setval")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	require.ErrorIs(t, Restore(context.Background(), db, bytes.NewReader(buf.Bytes()), false, nil), ErrKeyRequired)
+	require.ErrorContains(t, Restore(context.Background(), db, bytes.NewReader(buf.Bytes()), false, bytes.Repeat([]byte{8}, KeySize)), "wrong key")
+	require.NoError(t, Restore(context.Background(), db, bytes.NewReader(buf.Bytes()), false, testKey))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestTablesCoverReferences keeps Tables in step with the changelog: a table
+// with a foreign key to a backed up table has to be backed up as well, or a
+// truncating restore cannot empty its parent.
+func TestTablesCoverReferences(t *testing.T) {
+	changelog, err := os.ReadFile("../../liquibase/my_project_postgres/sql/my-project-changelog.sql")
+	require.NoError(t, err)
+
+	createTable := regexp.MustCompile(`(?s)create table (public\.\w+) \((.*?)\n\)`)
+	references := regexp.MustCompile(`references (public\.\w+)`)
+	tables := createTable.FindAllStringSubmatch(string(changelog), -1)
+	require.NotEmpty(t, tables)
+	for _, table := range tables {
+		require.Contains(t, Tables, table[1], "%s is not backed up", table[1])
+		for _, reference := range references.FindAllStringSubmatch(table[2], -1) {
+			if columnIndex(Tables, reference[1]) >= 0 {
+				require.Contains(t, Tables, table[1], "%s references %s", table[1], reference[1])
+			}
+		}
+	}
+}
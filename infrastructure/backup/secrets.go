@@ -0,0 +1,107 @@
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the length of the AES-256 key archives are encrypted with.
+const KeySize = 32
+
+// ErrKeyRequired is returned when an archive has encrypted columns but no key
+// was given.
+var ErrKeyRequired = errors.New("archive has encrypted columns and needs the backup key")
+
+// SecretColumns are encrypted in the archive: password and token hashes, and
+// the subjects linking accounts to OAuth providers.
+var SecretColumns = map[string][]string{
+	"public.user":                   {"password"},
+	"public.personal_access_tokens": {"token_hash"},
+	"public.user_identities":        {"subject"},
+	"public.email_verifications":    {"token_hash"},
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("backup key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptTable replaces the secret columns of table with AES-GCM ciphertext.
+// The table and column name are bound to every value, so ciphertext cannot
+// be moved to another column.
+func encryptTable(aead cipher.AEAD, name string, table *Table) error {
+	for _, column := range SecretColumns[name] {
+		index := columnIndex(table.Columns, column)
+		if index < 0 {
+			continue
+		}
+		for _, row := range table.Rows {
+			if row[index] == nil {
+				continue
+			}
+			plaintext, ok := row[index].(string)
+			if !ok {
+				return fmt.Errorf("encrypt %s.%s: unexpected %T", name, column, row[index])
+			}
+			nonce := make([]byte, aead.NonceSize())
+			if _, err := rand.Read(nonce); err != nil {
+				return err
+			}
+			sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(name+"."+column))
+			row[index] = base64.StdEncoding.EncodeToString(sealed)
+		}
+		table.Encrypted = append(table.Encrypted, column)
+	}
+	return nil
+}
+
+// decryptTable reverses encryptTable for the columns the archive lists as
+// encrypted.
+func decryptTable(aead cipher.AEAD, name string, table *Table) error {
+	for _, column := range table.Encrypted {
+		index := columnIndex(table.Columns, column)
+		if index < 0 {
+			return fmt.Errorf("decrypt %s.%s: column missing", name, column)
+		}
+		for _, row := range table.Rows {
+			if row[index] == nil {
+				continue
+			}
+			encoded, ok := row[index].(string)
+			if !ok {
+				return fmt.Errorf("decrypt %s.%s: unexpected %T", name, column, row[index])
+			}
+			sealed, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil || len(sealed) < aead.NonceSize() {
+				return fmt.Errorf("decrypt %s.%s: malformed value", name, column)
+			}
+			nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+			plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(name+"."+column))
+			if err != nil {
+				return fmt.Errorf("decrypt %s.%s: wrong key or corrupted archive", name, column)
+			}
+			row[index] = string(plaintext)
+		}
+	}
+	table.Encrypted = nil
+	return nil
+}
+
+func columnIndex(columns []string, name string) int {
+	for i, column := range columns {
+		if column == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"my-project/infrastructure/logger"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrSessionUnknown is returned by IsRevoked when the cache cannot vouch for a
+// session, either because Redis failed or because the in-memory cache has
+// not seen the session recently. The caller has to ask the database.
+var ErrSessionUnknown = errors.New("session state is not cached")
+
+// sessionTouchInterval is how often the last-seen time of a session is
+// written back to the database.
+const sessionTouchInterval = time.Minute
+
+// ISessionCache decides whether the tokens of a session are still accepted.
+// Redis is the authority for revocations. The in-memory fallback is local to
+// the process, so it only answers for sessions the database has confirmed
+// within the active_session TTL.
+type ISessionCache interface {
+	Revoke(ctx context.Context, sessionId int64)
+	IsRevoked(ctx context.Context, sessionId int64) (bool, error)
+	// MarkActive records that the database found the session active.
+	MarkActive(ctx context.Context, sessionId int64)
+	// TouchDue reports whether the session's last-seen time should be
+	// written, at most once per sessionTouchInterval.
+	TouchDue(ctx context.Context, sessionId int64) bool
+}
+
+// NewSessionCache stores revocations in Redis when a client is available and
+// falls back to process memory otherwise. A revocation only has to outlive
+// the access tokens issued for a session; once they have expired the session
+// cannot be used anyway.
+func NewSessionCache(redisClient *redis.Client) ISessionCache {
+	if redisClient == nil {
+		return &MemorySessionCache{
+			ttl:       TTL(EntityRevokedSession),
+			activeTTL: TTL(EntityActiveSession),
+			revoked:   make(map[int64]time.Time),
+			active:    make(map[int64]time.Time),
+			touched:   make(map[int64]time.Time),
+		}
+	}
+	return &RedisSessionCache{RedisClient: redisClient, ttl: TTL(EntityRevokedSession)}
+}
+
+type RedisSessionCache struct {
+	RedisClient *redis.Client
+	ttl         time.Duration
+}
+
+func revokedSessionKey(sessionId int64) string {
+	return "session:revoked:" + strconv.FormatInt(sessionId, 10)
+}
+
+func touchedSessionKey(sessionId int64) string {
+	return "session:touched:" + strconv.FormatInt(sessionId, 10)
+}
+
+func (c *RedisSessionCache) Revoke(ctx context.Context, sessionId int64) {
+	if err := c.RedisClient.Set(ctx, revokedSessionKey(sessionId), 1, c.ttl).Err(); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while save revoked session to redis")
+	}
+}
+
+func (c *RedisSessionCache) IsRevoked(ctx context.Context, sessionId int64) (bool, error) {
+	n, err := c.RedisClient.Exists(ctx, revokedSessionKey(sessionId)).Result()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get revoked session from redis")
+		return false, ErrSessionUnknown
+	}
+	return n > 0, nil
+}
+
+// MarkActive is a no-op: Redis already knows about every revocation.
+func (c *RedisSessionCache) MarkActive(ctx context.Context, sessionId int64) {}
+
+func (c *RedisSessionCache) TouchDue(ctx context.Context, sessionId int64) bool {
+	ok, err := c.RedisClient.SetNX(ctx, touchedSessionKey(sessionId), 1, sessionTouchInterval).Result()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while save touched session to redis")
+		return false
+	}
+	return ok
+}
+
+type MemorySessionCache struct {
+	ttl       time.Duration
+	activeTTL time.Duration
+	mu        sync.Mutex
+	revoked   map[int64]time.Time
+	active    map[int64]time.Time
+	touched   map[int64]time.Time
+	nextSweep time.Time
+}
+
+// sweep drops expired entries at most once per sessionTouchInterval, so
+// sessions that are never looked up again do not stay in memory. The caller
+// holds the lock.
+func (c *MemorySessionCache) sweep(now time.Time) {
+	if now.Before(c.nextSweep) {
+		return
+	}
+	c.nextSweep = now.Add(sessionTouchInterval)
+	for _, entries := range []map[int64]time.Time{c.revoked, c.active, c.touched} {
+		for sessionId, expiresAt := range entries {
+			if !now.Before(expiresAt) {
+				delete(entries, sessionId)
+			}
+		}
+	}
+}
+
+func (c *MemorySessionCache) Revoke(ctx context.Context, sessionId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.sweep(now)
+	c.revoked[sessionId] = now.Add(c.ttl)
+	delete(c.active, sessionId)
+}
+
+func (c *MemorySessionCache) IsRevoked(ctx context.Context, sessionId int64) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if expiresAt, ok := c.revoked[sessionId]; ok {
+		if now.Before(expiresAt) {
+			return true, nil
+		}
+		delete(c.revoked, sessionId)
+	}
+	if expiresAt, ok := c.active[sessionId]; ok {
+		if now.Before(expiresAt) {
+			return false, nil
+		}
+		delete(c.active, sessionId)
+	}
+	return false, ErrSessionUnknown
+}
+
+func (c *MemorySessionCache) MarkActive(ctx context.Context, sessionId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.sweep(now)
+	c.active[sessionId] = now.Add(c.activeTTL)
+}
+
+func (c *MemorySessionCache) TouchDue(ctx context.Context, sessionId int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.sweep(now)
+	if next, ok := c.touched[sessionId]; ok && now.Before(next) {
+		return false
+	}
+	c.touched[sessionId] = now.Add(sessionTouchInterval)
+	return true
+}
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemorySessionCache(t *testing.T) {
+	sessionCache := NewSessionCache(nil)
+
+	_, err := sessionCache.IsRevoked(context.Background(), 1)
+	require.ErrorIs(t, err, ErrSessionUnknown, "unseen sessions are left to the database")
+
+	sessionCache.MarkActive(context.Background(), 1)
+	revoked, err := sessionCache.IsRevoked(context.Background(), 1)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	sessionCache.Revoke(context.Background(), 1)
+	revoked, err = sessionCache.IsRevoked(context.Background(), 1)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	assert.True(t, sessionCache.TouchDue(context.Background(), 1))
+	assert.False(t, sessionCache.TouchDue(context.Background(), 1), "touches are throttled")
+}
+
+func TestMemorySessionCacheSweepsExpiredEntries(t *testing.T) {
+	sessionCache := &MemorySessionCache{
+		ttl:       time.Millisecond,
+		activeTTL: time.Millisecond,
+		revoked:   make(map[int64]time.Time),
+		active:    make(map[int64]time.Time),
+		touched:   make(map[int64]time.Time),
+	}
+	sessionCache.Revoke(context.Background(), 1)
+	sessionCache.MarkActive(context.Background(), 2)
+
+	time.Sleep(5 * time.Millisecond)
+	sessionCache.nextSweep = time.Time{}
+	sessionCache.MarkActive(context.Background(), 3)
+
+	assert.Empty(t, sessionCache.revoked)
+	assert.Len(t, sessionCache.active, 1)
+}
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"github.com/redis/go-redis/v9"
+	"my-project/infrastructure/feature"
 	"my-project/infrastructure/logger"
 	"time"
 )
@@ -14,19 +15,26 @@ type ITestCache interface {
 
 type TestCache struct {
 	RedisClient *redis.Client
+	ttl         time.Duration
 }
 
 func NewTestCache(redisClient *redis.Client) ITestCache {
-	return &TestCache{RedisClient: redisClient}
+	return &TestCache{RedisClient: redisClient, ttl: TTL(EntityTest)}
 }
 
 func (c *TestCache) Set(ctx context.Context, key string, value interface{}) {
-	err := c.RedisClient.Set(ctx, key, value, time.Second*30)
+	if c.RedisClient == nil {
+		return
+	}
+	err := c.RedisClient.Set(ctx, key, value, c.ttl)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while save redis")
 	}
 }
 
 func (c *TestCache) Get(ctx context.Context, key string) (interface{}, error) {
+	if c.RedisClient == nil {
+		return nil, feature.ErrDisabled
+	}
 	return c.RedisClient.Get(ctx, key).Result()
 }
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"my-project/domain/model"
+	"my-project/infrastructure/configuration"
+	"time"
+)
+
+// Cache entity names used as keys of the cache.ttlSeconds configuration.
+const (
+	EntityUser           = "user"
+	EntityRevokedSession = "revoked_session"
+	EntityActiveSession  = "active_session"
+	EntityTest           = "test"
+)
+
+var defaultTTLs = map[string]time.Duration{
+	EntityUser:           30 * time.Second,
+	EntityRevokedSession: 10 * time.Minute,
+	EntityActiveSession:  30 * time.Second,
+	EntityTest:           30 * time.Second,
+}
+
+// TTL returns the effective lifetime of an entity: its own override, then the
+// configured default, then the built-in value.
+func TTL(entity string) time.Duration {
+	cfg := configuration.C.Cache
+	ttl := defaultTTLs[entity]
+	if cfg.DefaultTTLSeconds > 0 {
+		ttl = time.Duration(cfg.DefaultTTLSeconds) * time.Second
+	}
+	if seconds := cfg.TTLSeconds[entity]; seconds > 0 {
+		ttl = time.Duration(seconds) * time.Second
+	}
+	// A revocation that expires before the session's tokens would let them
+	// through again.
+	if entity == EntityRevokedSession && ttl < model.SessionTokenLifetime {
+		ttl = model.SessionTokenLifetime
+	}
+	return ttl
+}
+
+// TTLs returns the effective lifetime of every cache entity.
+func TTLs() map[string]time.Duration {
+	ttls := make(map[string]time.Duration, len(defaultTTLs))
+	for entity := range defaultTTLs {
+		ttls[entity] = TTL(entity)
+	}
+	return ttls
+}
@@ -0,0 +1,24 @@
+package cache
+
+import (
+	"my-project/infrastructure/configuration"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTL(t *testing.T) {
+	defer func() { configuration.C.Cache = configuration.Cache{} }()
+
+	assert.Equal(t, 30*time.Second, TTL(EntityUser))
+	assert.Equal(t, 10*time.Minute, TTL(EntityRevokedSession))
+
+	configuration.C.Cache = configuration.Cache{
+		DefaultTTLSeconds: 60,
+		TTLSeconds:        map[string]int{EntityUser: 5},
+	}
+	assert.Equal(t, 5*time.Second, TTL(EntityUser))
+	assert.Equal(t, time.Minute, TTL(EntityTest))
+	assert.Equal(t, 5*time.Minute, TTL(EntityRevokedSession), "revocations outlive access tokens")
+}
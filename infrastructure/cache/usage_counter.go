@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"my-project/domain/model"
+	"my-project/infrastructure/logger"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	usagePendingKey = "usage:pending"
+	usageDayLayout  = "2006-01-02"
+)
+
+// IUsageCounter counts API requests until they are flushed to the database.
+// Drain hands back everything counted so far and resets the counters.
+type IUsageCounter interface {
+	Add(ctx context.Context, usage model.APIUsage)
+	Drain(ctx context.Context) ([]model.APIUsage, error)
+}
+
+// NewUsageCounter counts in Redis when a client is available, so every
+// instance adds to the same counters, and in process memory otherwise.
+func NewUsageCounter(redisClient *redis.Client) IUsageCounter {
+	if redisClient == nil {
+		return &MemoryUsageCounter{counts: make(map[usageKey]int64)}
+	}
+	return &RedisUsageCounter{RedisClient: redisClient}
+}
+
+type usageKey struct {
+	userId   int64
+	day      string
+	endpoint string
+}
+
+func (key usageKey) field() string {
+	return fmt.Sprintf("%s|%d|%s", key.day, key.userId, key.endpoint)
+}
+
+func usageKeyOf(usage model.APIUsage) usageKey {
+	return usageKey{userId: usage.UserID, day: usage.Day.UTC().Format(usageDayLayout), endpoint: usage.Endpoint}
+}
+
+func (key usageKey) usage(count int64) (model.APIUsage, error) {
+	day, err := time.Parse(usageDayLayout, key.day)
+	if err != nil {
+		return model.APIUsage{}, err
+	}
+	return model.APIUsage{UserID: key.userId, Day: day, Endpoint: key.endpoint, Count: count}, nil
+}
+
+type RedisUsageCounter struct {
+	RedisClient *redis.Client
+}
+
+func (c *RedisUsageCounter) Add(ctx context.Context, usage model.APIUsage) {
+	if err := c.RedisClient.HIncrBy(ctx, usagePendingKey, usageKeyOf(usage).field(), usage.Count).Err(); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while count usage in redis")
+	}
+}
+
+// Drain renames the pending hash before reading it, so requests counted while
+// the flush runs land in a fresh hash instead of being lost.
+func (c *RedisUsageCounter) Drain(ctx context.Context) ([]model.APIUsage, error) {
+	exists, err := c.RedisClient.Exists(ctx, usagePendingKey).Result()
+	if err != nil || exists == 0 {
+		return nil, err
+	}
+
+	drainKey := usagePendingKey + ":draining:" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := c.RedisClient.Rename(ctx, usagePendingKey, drainKey).Err(); err != nil {
+		return nil, err
+	}
+	fields, err := c.RedisClient.HGetAll(ctx, drainKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.RedisClient.Del(ctx, drainKey).Err(); err != nil {
+		logger.GetLogger().WithField("error", err).WithField("key", drainKey).Error("Error while delete drained usage from redis")
+	}
+
+	usages := make([]model.APIUsage, 0, len(fields))
+	for field, value := range fields {
+		parts := strings.SplitN(field, "|", 3)
+		count, countErr := strconv.ParseInt(value, 10, 64)
+		if len(parts) != 3 || countErr != nil {
+			logger.GetLogger().WithField("field", field).Warn("Skipping malformed usage counter")
+			continue
+		}
+		userId, idErr := strconv.ParseInt(parts[1], 10, 64)
+		usage, dayErr := usageKey{userId: userId, day: parts[0], endpoint: parts[2]}.usage(count)
+		if idErr != nil || dayErr != nil {
+			logger.GetLogger().WithField("field", field).Warn("Skipping malformed usage counter")
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+type MemoryUsageCounter struct {
+	mu     sync.Mutex
+	counts map[usageKey]int64
+}
+
+func (c *MemoryUsageCounter) Add(ctx context.Context, usage model.APIUsage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counts[usageKeyOf(usage)] += usage.Count
+}
+
+func (c *MemoryUsageCounter) Drain(ctx context.Context) ([]model.APIUsage, error) {
+	c.mu.Lock()
+	counts := c.counts
+	c.counts = make(map[usageKey]int64)
+	c.mu.Unlock()
+
+	usages := make([]model.APIUsage, 0, len(counts))
+	for key, count := range counts {
+		usage, err := key.usage(count)
+		if err != nil {
+			return usages, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"my-project/domain/model"
+	"my-project/infrastructure/logger"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// IUserCache holds users looked up by the auth middleware. Cached users never
+// carry the password hash.
+type IUserCache interface {
+	Get(ctx context.Context, userName string) (model.User, bool)
+	Set(ctx context.Context, user model.User)
+	Invalidate(ctx context.Context, userName string)
+}
+
+// NewUserCache stores users in Redis when a client is available and falls
+// back to process memory otherwise.
+func NewUserCache(redisClient *redis.Client) IUserCache {
+	if redisClient == nil {
+		return &MemoryUserCache{ttl: TTL(EntityUser), users: make(map[string]memoryUser)}
+	}
+	return &RedisUserCache{RedisClient: redisClient, ttl: TTL(EntityUser)}
+}
+
+type RedisUserCache struct {
+	RedisClient *redis.Client
+	ttl         time.Duration
+}
+
+func userKey(userName string) string {
+	return "user:" + userName
+}
+
+func (c *RedisUserCache) Get(ctx context.Context, userName string) (model.User, bool) {
+	var user model.User
+	value, err := c.RedisClient.Get(ctx, userKey(userName)).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			logger.GetLogger().WithField("error", err).Error("Error while get user from redis")
+		}
+		return user, false
+	}
+	if err := json.Unmarshal(value, &user); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while unmarshal cached user")
+		return user, false
+	}
+	return user, true
+}
+
+func (c *RedisUserCache) Set(ctx context.Context, user model.User) {
+	user.Password = ""
+	value, err := json.Marshal(user)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while marshal user")
+		return
+	}
+	if err := c.RedisClient.Set(ctx, userKey(user.UserName), value, c.ttl).Err(); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while save user to redis")
+	}
+}
+
+func (c *RedisUserCache) Invalidate(ctx context.Context, userName string) {
+	if err := c.RedisClient.Del(ctx, userKey(userName)).Err(); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while delete user from redis")
+	}
+}
+
+type memoryUser struct {
+	user      model.User
+	expiresAt time.Time
+}
+
+type MemoryUserCache struct {
+	ttl       time.Duration
+	mu        sync.Mutex
+	users     map[string]memoryUser
+	nextSweep time.Time
+}
+
+// sweep drops expired users at most once per TTL, so users who never return
+// do not stay in memory. The caller holds the lock.
+func (c *MemoryUserCache) sweep(now time.Time) {
+	if now.Before(c.nextSweep) {
+		return
+	}
+	c.nextSweep = now.Add(c.ttl)
+	for userName, entry := range c.users {
+		if !now.Before(entry.expiresAt) {
+			delete(c.users, userName)
+		}
+	}
+}
+
+func (c *MemoryUserCache) Get(ctx context.Context, userName string) (model.User, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.users[userName]
+	if !ok {
+		return model.User{}, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.users, userName)
+		return model.User{}, false
+	}
+	return entry.user, true
+}
+
+func (c *MemoryUserCache) Set(ctx context.Context, user model.User) {
+	user.Password = ""
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.sweep(now)
+	c.users[user.UserName] = memoryUser{user: user, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *MemoryUserCache) Invalidate(ctx context.Context, userName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.users, userName)
+}
@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"context"
+	"my-project/domain/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryUserCacheSweepsExpiredUsers(t *testing.T) {
+	userCache := &MemoryUserCache{ttl: time.Millisecond, users: make(map[string]memoryUser)}
+	userCache.Set(context.Background(), model.User{UserName: "once"})
+
+	time.Sleep(5 * time.Millisecond)
+	userCache.Set(context.Background(), model.User{UserName: "again"})
+
+	assert.Len(t, userCache.users, 1, "an expired user is dropped without being read")
+	_, ok := userCache.Get(context.Background(), "again")
+	assert.True(t, ok)
+}
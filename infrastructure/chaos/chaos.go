@@ -0,0 +1,143 @@
+package chaos
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"my-project/infrastructure/configuration"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fault is what Injector decided to do with a single request.
+type Fault int
+
+const (
+	None Fault = iota
+	TooManyRequests
+	ServerError
+)
+
+// Injector draws faults at the configured rates. Rates are probabilities
+// between 0 and 1 and are drawn independently of each other.
+type Injector struct {
+	latency         time.Duration
+	latencyRate     float64
+	rateLimitRate   float64
+	serverErrorRate float64
+
+	mu     sync.Mutex
+	random func() float64
+}
+
+// NewInjector returns nil unless chaos is enabled and ENV is not a production
+// environment, so a stray config flag can never reach real users.
+func NewInjector(cfg configuration.Chaos, env string) *Injector {
+	if !cfg.Enabled || isProduction(env) {
+		return nil
+	}
+	return &Injector{
+		latency:         time.Duration(cfg.LatencyMs) * time.Millisecond,
+		latencyRate:     cfg.LatencyRate,
+		rateLimitRate:   cfg.RateLimitRate,
+		serverErrorRate: cfg.ServerErrorRate,
+		random:          rand.Float64,
+	}
+}
+
+func isProduction(env string) bool {
+	env = strings.ToLower(env)
+	return env == "prod" || env == "production"
+}
+
+func (injector *Injector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	return injector.random() < rate
+}
+
+// Delay returns how long the request should be held back.
+func (injector *Injector) Delay() time.Duration {
+	if injector.latency > 0 && injector.roll(injector.latencyRate) {
+		return injector.latency
+	}
+	return 0
+}
+
+// Fault picks the error, if any, the request should fail with.
+func (injector *Injector) Fault() Fault {
+	if injector.roll(injector.rateLimitRate) {
+		return TooManyRequests
+	}
+	if injector.roll(injector.serverErrorRate) {
+		return ServerError
+	}
+	return None
+}
+
+// StatusCode maps a fault to the HTTP status it is reported with.
+func (fault Fault) StatusCode() int {
+	switch fault {
+	case TooManyRequests:
+		return http.StatusTooManyRequests
+	case ServerError:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusOK
+}
+
+// Transport wraps an upstream round tripper and fails or delays outbound
+// calls, standing in for a misbehaving third-party API.
+type Transport struct {
+	Base     http.RoundTripper
+	Injector *Injector
+}
+
+// Wrap returns base unchanged when injector is nil.
+func (injector *Injector) Wrap(base http.RoundTripper) http.RoundTripper {
+	if injector == nil {
+		return base
+	}
+	return &Transport{Base: base, Injector: injector}
+}
+
+func (transport *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if delay := transport.Injector.Delay(); delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	if fault := transport.Injector.Fault(); fault != None {
+		header := http.Header{}
+		header.Set("Content-Type", "application/json")
+		if fault == TooManyRequests {
+			header.Set("Retry-After", "1")
+		}
+		return &http.Response{
+			Status:     http.StatusText(fault.StatusCode()),
+			StatusCode: fault.StatusCode(),
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"error":"injected fault"}`)),
+			Request:    req,
+		}, nil
+	}
+
+	base := transport.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,48 @@
+package chaos
+
+import (
+	"my-project/infrastructure/configuration"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInjectorRefusesProduction(t *testing.T) {
+	cfg := configuration.Chaos{Enabled: true, ServerErrorRate: 1}
+
+	assert.Nil(t, NewInjector(cfg, "prod"))
+	assert.Nil(t, NewInjector(configuration.Chaos{ServerErrorRate: 1}, ""))
+	assert.NotNil(t, NewInjector(cfg, "stage"))
+}
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		cfg  configuration.Chaos
+		want int
+	}{
+		{name: "no faults", cfg: configuration.Chaos{Enabled: true}, want: http.StatusOK},
+		{name: "rate limited", cfg: configuration.Chaos{Enabled: true, RateLimitRate: 1}, want: http.StatusTooManyRequests},
+		{name: "server error", cfg: configuration.Chaos{Enabled: true, ServerErrorRate: 1}, want: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &http.Client{Transport: NewInjector(tt.cfg, "").Wrap(http.DefaultTransport)}
+
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+}
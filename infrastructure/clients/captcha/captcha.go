@@ -0,0 +1,95 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"my-project/infrastructure/clients"
+	"my-project/infrastructure/configuration"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	ReCaptcha = "recaptcha"
+	HCaptcha  = "hcaptcha"
+)
+
+var verifyURLs = map[string]string{
+	ReCaptcha: "https://www.google.com/recaptcha/api/siteverify",
+	HCaptcha:  "https://api.hcaptcha.com/siteverify",
+}
+
+// ErrRejected is returned when the provider did not accept the token.
+var ErrRejected = errors.New("captcha rejected")
+
+type ICaptcha interface {
+	Verify(ctx context.Context, token string, remoteIP string) error
+}
+
+type Verifier struct {
+	secretKey  string
+	verifyURL  string
+	httpClient *http.Client
+}
+
+// NewVerifier returns nil when captcha is disabled or the provider is not
+// configured, so callers can skip verification.
+func NewVerifier(cfg configuration.Captcha) ICaptcha {
+	verifyURL, ok := verifyURLs[cfg.Provider]
+	if !cfg.Enabled || !ok || cfg.SecretKey == "" {
+		return nil
+	}
+	return &Verifier{
+		secretKey:  cfg.SecretKey,
+		verifyURL:  verifyURL,
+		httpClient: &http.Client{Timeout: 5 * time.Second, Transport: clients.NewTransport(clients.EgressCaptcha)},
+	}
+}
+
+// Verify checks the token against the provider's siteverify endpoint. Both
+// reCAPTCHA and hCaptcha share the same request and response shape.
+func (verifier *Verifier) Verify(ctx context.Context, token string, remoteIP string) error {
+	if token == "" {
+		return ErrRejected
+	}
+
+	form := url.Values{}
+	form.Set("secret", verifier.secretKey)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, verifier.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := verifier.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("siteverify: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("siteverify: unexpected status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("siteverify: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("%w: %s", ErrRejected, strings.Join(result.ErrorCodes, ","))
+	}
+
+	return nil
+}
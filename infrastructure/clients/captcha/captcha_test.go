@@ -0,0 +1,36 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"my-project/infrastructure/configuration"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVerifierDisabled(t *testing.T) {
+	assert.Nil(t, NewVerifier(configuration.Captcha{Provider: ReCaptcha, SecretKey: "secret"}))
+	assert.Nil(t, NewVerifier(configuration.Captcha{Enabled: true, Provider: "unknown", SecretKey: "secret"}))
+	assert.NotNil(t, NewVerifier(configuration.Captcha{Enabled: true, Provider: HCaptcha, SecretKey: "secret"}))
+}
+
+func TestVerifierVerify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.FormValue("secret"))
+		if r.FormValue("response") == "good" {
+			w.Write([]byte(`{"success": true}`))
+			return
+		}
+		w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier := &Verifier{secretKey: "secret", verifyURL: server.URL, httpClient: server.Client()}
+
+	assert.NoError(t, verifier.Verify(context.Background(), "good", "127.0.0.1"))
+	assert.True(t, errors.Is(verifier.Verify(context.Background(), "bad", ""), ErrRejected))
+	assert.True(t, errors.Is(verifier.Verify(context.Background(), "", ""), ErrRejected))
+}
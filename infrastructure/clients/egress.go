@@ -0,0 +1,73 @@
+package clients
+
+import (
+	"fmt"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/logger"
+	"net/http"
+	"net/url"
+)
+
+// Client names used to look up per-client proxy overrides.
+const (
+	EgressTulusTech = "tulustech"
+	EgressCaptcha   = "captcha"
+	EgressOIDC      = "oidc"
+)
+
+// EgressDirect in the egress configuration bypasses the proxy.
+const EgressDirect = "direct"
+
+// ProxyURL resolves the proxy a client should use. A nil URL without direct
+// means the client falls back to the proxy environment variables.
+func ProxyURL(cfg configuration.Egress, client string) (proxyURL *url.URL, direct bool, err error) {
+	proxy := cfg.Proxy
+	if override, ok := cfg.Clients[client]; ok {
+		proxy = override
+	}
+
+	switch proxy {
+	case "":
+		return nil, false, nil
+	case EgressDirect:
+		return nil, true, nil
+	}
+
+	proxyURL, err = url.Parse(proxy)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid egress proxy for %q: %w", client, err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, false, fmt.Errorf("invalid egress proxy for %q: unsupported scheme %q", client, proxyURL.Scheme)
+	}
+	if proxyURL.Host == "" {
+		return nil, false, fmt.Errorf("invalid egress proxy for %q: missing host", client)
+	}
+	return proxyURL, false, nil
+}
+
+// ProxyFunc returns the http.Transport proxy function for a client. A
+// misconfigured proxy fails every request rather than silently going direct.
+func ProxyFunc(cfg configuration.Egress, client string) func(*http.Request) (*url.URL, error) {
+	proxyURL, direct, err := ProxyURL(cfg, client)
+	switch {
+	case err != nil:
+		logger.GetLogger().WithField("error", err).Error("Error while configure egress proxy")
+		return func(*http.Request) (*url.URL, error) { return nil, err }
+	case direct:
+		return nil
+	case proxyURL == nil:
+		return http.ProxyFromEnvironment
+	}
+	return http.ProxyURL(proxyURL)
+}
+
+// NewTransport returns a transport with the default settings that sends its
+// requests through the client's configured egress proxy.
+func NewTransport(client string) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = ProxyFunc(configuration.C.Egress, client)
+	return transport
+}
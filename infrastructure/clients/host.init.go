@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"my-project/infrastructure/configuration"
 	"my-project/infrastructure/logger"
 	"net/http"
 	"os"
@@ -14,6 +15,10 @@ import (
 	"github.com/google/go-querystring/query"
 )
 
+// TransportWrapper, when set, wraps the transport of every outbound request.
+// It lets fault injection stand in front of upstream services in development.
+var TransportWrapper func(http.RoundTripper) http.RoundTripper
+
 // HostInterface abstract class
 type HostInterface interface {
 	HTTPPost() ([]byte, int, error)
@@ -130,8 +135,13 @@ func (host *HostStruct) Do(req *http.Request) ([]byte, int, error) {
 		MaxIdleConnsPerHost: 100,
 		MaxConnsPerHost:     100,
 		IdleConnTimeout:     600 * time.Second,
+		Proxy:               ProxyFunc(configuration.C.Egress, EgressTulusTech),
+	}
+	var transport http.RoundTripper = tr
+	if TransportWrapper != nil {
+		transport = TransportWrapper(transport)
 	}
-	host.HTTPClient = &http.Client{Timeout: time.Second * 20, Transport: tr}
+	host.HTTPClient = &http.Client{Timeout: time.Second * 20, Transport: transport}
 
 	host.HTTPResponse, host.Err = host.HTTPClient.Do(req)
 	// Todo: Handle network error
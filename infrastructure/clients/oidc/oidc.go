@@ -0,0 +1,170 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"my-project/domain/model"
+	"my-project/infrastructure/clients"
+	"my-project/infrastructure/configuration"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/microsoft"
+)
+
+const (
+	Google    = "google"
+	Microsoft = "microsoft"
+)
+
+// probeCode is a code no provider will have issued. Exchanging it fails with
+// invalid_grant once the client has been authenticated, and with
+// invalid_client when the client id or secret is wrong.
+const probeCode = "credential-check"
+
+var ErrSubjectMissing = errors.New("provider did not return a subject")
+
+type IProvider interface {
+	AuthCodeURL(state string) string
+	Exchange(ctx context.Context, code string) (model.OIDCIdentity, error)
+	CheckCredentials(ctx context.Context) error
+}
+
+type Provider struct {
+	name        string
+	config      *oauth2.Config
+	userInfoURL string
+	httpClient  *http.Client
+}
+
+// NewProviders builds a provider for every entry in the oidc configuration
+// that has a client id. Unconfigured providers are left out of the map.
+func NewProviders(cfg configuration.OIDC) map[string]IProvider {
+	providers := make(map[string]IProvider)
+	scopes := []string{"openid", "email", "profile"}
+	httpClient := &http.Client{Transport: clients.NewTransport(clients.EgressOIDC)}
+
+	if cfg.Google.ClientID != "" {
+		providers[Google] = &Provider{
+			name: Google,
+			config: &oauth2.Config{
+				ClientID:     cfg.Google.ClientID,
+				ClientSecret: cfg.Google.ClientSecret,
+				RedirectURL:  cfg.Google.RedirectURL,
+				Endpoint:     google.Endpoint,
+				Scopes:       scopes,
+			},
+			userInfoURL: "https://openidconnect.googleapis.com/v1/userinfo",
+			httpClient:  httpClient,
+		}
+	}
+	if cfg.Microsoft.ClientID != "" {
+		tenant := cfg.Microsoft.Tenant
+		if tenant == "" {
+			tenant = "common"
+		}
+		providers[Microsoft] = &Provider{
+			name: Microsoft,
+			config: &oauth2.Config{
+				ClientID:     cfg.Microsoft.ClientID,
+				ClientSecret: cfg.Microsoft.ClientSecret,
+				RedirectURL:  cfg.Microsoft.RedirectURL,
+				Endpoint:     microsoft.AzureADEndpoint(tenant),
+				Scopes:       scopes,
+			},
+			userInfoURL: "https://graph.microsoft.com/oidc/userinfo",
+			httpClient:  httpClient,
+		}
+	}
+
+	return providers
+}
+
+func (provider *Provider) AuthCodeURL(state string) string {
+	return provider.config.AuthCodeURL(state)
+}
+
+// Exchange trades the authorization code for a token and reads the signed-in
+// user's claims from the provider's userinfo endpoint.
+func (provider *Provider) Exchange(ctx context.Context, code string) (model.OIDCIdentity, error) {
+	var identity model.OIDCIdentity
+	ctx = provider.withHTTPClient(ctx)
+
+	token, err := provider.config.Exchange(ctx, code)
+	if err != nil {
+		return identity, fmt.Errorf("exchange code: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.userInfoURL, nil)
+	if err != nil {
+		return identity, err
+	}
+	resp, err := provider.config.Client(ctx, token).Do(req)
+	if err != nil {
+		return identity, fmt.Errorf("userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return identity, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return identity, fmt.Errorf("userinfo: unexpected status %d", resp.StatusCode)
+	}
+
+	var claims struct {
+		Subject       string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return identity, err
+	}
+	if claims.Subject == "" {
+		return identity, ErrSubjectMissing
+	}
+
+	identity.Provider = provider.name
+	identity.Subject = claims.Subject
+	identity.Email = claims.Email
+	// Microsoft omits email_verified and lets tenants set any address, so
+	// only an explicit true counts.
+	identity.EmailVerified = claims.EmailVerified != nil && *claims.EmailVerified
+	identity.Name = claims.Name
+	return identity, nil
+}
+
+// CheckCredentials verifies the client id and secret against the token
+// endpoint without a user having to sign in.
+func (provider *Provider) CheckCredentials(ctx context.Context) error {
+	_, err := provider.config.Exchange(provider.withHTTPClient(ctx), probeCode)
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		if err == nil {
+			return errors.New("provider accepted an invalid code")
+		}
+		return err
+	}
+	if retrieveErr.ErrorCode == "invalid_grant" {
+		return nil
+	}
+	if retrieveErr.ErrorCode != "" {
+		return fmt.Errorf("client credentials rejected: %s", retrieveErr.ErrorCode)
+	}
+	return fmt.Errorf("token endpoint returned %s", retrieveErr.Response.Status)
+}
+
+// withHTTPClient makes the oauth2 package send its token and userinfo requests
+// through the provider's egress transport.
+func (provider *Provider) withHTTPClient(ctx context.Context) context.Context {
+	if provider.httpClient == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, provider.httpClient)
+}
@@ -0,0 +1,71 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestProviderCheckCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		if clientId, _, _ := r.BasicAuth(); clientId == "good" {
+			w.Write([]byte(`{"error": "invalid_grant"}`))
+			return
+		}
+		w.Write([]byte(`{"error": "invalid_client"}`))
+	}))
+	defer server.Close()
+
+	newProvider := func(clientId string) *Provider {
+		return &Provider{config: &oauth2.Config{
+			ClientID:     clientId,
+			ClientSecret: "secret",
+			Endpoint:     oauth2.Endpoint{TokenURL: server.URL, AuthStyle: oauth2.AuthStyleInHeader},
+		}}
+	}
+
+	assert.NoError(t, newProvider("good").CheckCredentials(context.Background()))
+	assert.EqualError(t, newProvider("bad").CheckCredentials(context.Background()), "client credentials rejected: invalid_client")
+}
+
+func TestProviderExchangeEmailVerified(t *testing.T) {
+	tests := []struct {
+		name     string
+		userInfo string
+		want     bool
+	}{
+		{name: "verified", userInfo: `{"sub": "1234", "email": "tulus@example.com", "email_verified": true}`, want: true},
+		{name: "not verified", userInfo: `{"sub": "1234", "email": "tulus@example.com", "email_verified": false}`, want: false},
+		{name: "claim missing", userInfo: `{"sub": "1234", "email": "tulus@example.com"}`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/token" {
+					w.Write([]byte(`{"access_token": "token", "token_type": "Bearer"}`))
+					return
+				}
+				w.Write([]byte(tt.userInfo))
+			}))
+			defer server.Close()
+
+			provider := &Provider{
+				name:        Microsoft,
+				config:      &oauth2.Config{ClientID: "id", Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}},
+				userInfoURL: server.URL + "/userinfo",
+			}
+			identity, err := provider.Exchange(context.Background(), "code")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "1234", identity.Subject)
+			assert.Equal(t, tt.want, identity.EmailVerified)
+		})
+	}
+}
@@ -20,18 +20,46 @@ type Config struct {
 	RedisClient      RedisClient      `json:"redisClient"`
 	Logger           Logger           `json:"logger"`
 	ControlroomProxy ControlroomProxy `json:"controlroomProxy"`
+	Retention        Retention        `json:"retention"`
+	OIDC             OIDC             `json:"oidc"`
+	Captcha          Captcha          `json:"captcha"`
+	Registration     Registration     `json:"registration"`
+	Chaos            Chaos            `json:"chaos"`
+	Diagnostics      Diagnostics      `json:"diagnostics"`
+	Egress           Egress           `json:"egress"`
+	Usage            Usage            `json:"usage"`
+	Cache            Cache            `json:"cache"`
+	Mail             Mail             `json:"mail"`
 }
 
 type App struct {
-	Port      int    `json:"port"`
-	SecretKey string `json:"secretKey"`
+	Port      int      `json:"port"`
+	SecretKey string   `json:"secretKey"`
+	Admins    []string `json:"admins"`
 }
 
 type Database struct {
-	Openapi     OpenapiDb     `json:"openapi"`
-	Controlroom ControlroomDb `json:"controlroom"`
-	Psql        Db            `json:"psql"`
-	MySql       Db            `json:"mysql"`
+	Openapi      OpenapiDb     `json:"openapi"`
+	Controlroom  ControlroomDb `json:"controlroom"`
+	Psql         Db            `json:"psql"`
+	MySql        Db            `json:"mysql"`
+	QueryLog     QueryLog      `json:"queryLog"`
+	QueryTimeout QueryTimeout  `json:"queryTimeout"`
+}
+
+// QueryTimeout bounds each repository call. DefaultMs applies when the caller
+// set no deadline; MaxMs caps any deadline. Zero disables the bound.
+type QueryTimeout struct {
+	DefaultMs int `json:"defaultMs"`
+	MaxMs     int `json:"maxMs"`
+}
+
+// QueryLog times SQL statements; those at or above SlowThresholdMs are logged
+// and counted per repository method.
+type QueryLog struct {
+	Enabled         bool `json:"enabled"`
+	SlowThresholdMs int  `json:"slowThresholdMs"`
+	LogAll          bool `json:"logAll"`
 }
 
 type GoogleSheet struct {
@@ -121,6 +149,98 @@ type RedisClient struct {
 	Username     string `json:"username"`
 }
 
+type Retention struct {
+	SearchHistoryDays      int `json:"searchHistoryDays"`
+	CleanupIntervalMinutes int `json:"cleanupIntervalMinutes"`
+}
+
+type OIDC struct {
+	Google    OIDCProvider `json:"google"`
+	Microsoft OIDCProvider `json:"microsoft"`
+}
+
+type OIDCProvider struct {
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+	RedirectURL  string `json:"redirectUrl"`
+	Tenant       string `json:"tenant"`
+}
+
+// Captcha configures verification on /login and /register. Provider is
+// either "recaptcha" or "hcaptcha".
+type Captcha struct {
+	Enabled   bool   `json:"enabled"`
+	Provider  string `json:"provider"`
+	SiteKey   string `json:"siteKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// Registration gates new accounts. When either domain list is set an email
+// address is required; denied domains win over allowed ones. With
+// RequireApproval new accounts wait for an admin before they can log in.
+// An address entered at registration only counts once the user has opened
+// the link mailed to it; VerifyEmailURL is where that link points and gets
+// the token appended as a query parameter.
+type Registration struct {
+	AllowedDomains  []string `json:"allowedDomains"`
+	DeniedDomains   []string `json:"deniedDomains"`
+	RequireApproval bool     `json:"requireApproval"`
+	VerifyEmailURL  string   `json:"verifyEmailUrl"`
+}
+
+// Chaos injects latency and errors into incoming requests and outbound
+// upstream calls. It is ignored when ENV is prod or production.
+type Chaos struct {
+	Enabled         bool    `json:"enabled"`
+	LatencyMs       int     `json:"latencyMs"`
+	LatencyRate     float64 `json:"latencyRate"`
+	RateLimitRate   float64 `json:"rateLimitRate"`
+	ServerErrorRate float64 `json:"serverErrorRate"`
+}
+
+// Diagnostics enables the block and mutex profiles served under
+// /api/admin/debug/pprof. Both are off when zero; see
+// runtime.SetBlockProfileRate and runtime.SetMutexProfileFraction.
+type Diagnostics struct {
+	BlockProfileRate     int `json:"blockProfileRate"`
+	MutexProfileFraction int `json:"mutexProfileFraction"`
+}
+
+// Egress routes outbound calls through a proxy. Proxy applies to every
+// client and Clients overrides it by client name ("tulustech", "captcha",
+// "oidc"); "direct" bypasses the proxy. An empty Proxy falls back to the
+// HTTPS_PROXY environment variables. RequiredHosts are probed at startup and
+// by the doctor command.
+type Egress struct {
+	Proxy         string            `json:"proxy"`
+	Clients       map[string]string `json:"clients"`
+	RequiredHosts []string          `json:"requiredHosts"`
+}
+
+// Usage counts API requests per user. Counting is off when
+// FlushIntervalSeconds is zero.
+type Usage struct {
+	FlushIntervalSeconds int `json:"flushIntervalSeconds"`
+}
+
+// Cache sets how long cached entries live. DefaultTTLSeconds applies to every
+// entity and TTLSeconds overrides it by entity name ("user",
+// "revoked_session", "active_session", "test"). Zero keeps the built-in
+// lifetime.
+type Cache struct {
+	DefaultTTLSeconds int            `json:"defaultTtlSeconds"`
+	TTLSeconds        map[string]int `json:"ttlSeconds"`
+}
+
+// Mail sends email over SMTP. Nothing is sent while Host is empty.
+type Mail struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
 type Logger struct {
 	Format string `json:"format"`
 }
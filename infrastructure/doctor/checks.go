@@ -0,0 +1,237 @@
+package doctor
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"my-project/infrastructure/clients"
+	"my-project/infrastructure/clients/oidc"
+	"my-project/infrastructure/configuration"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Config reports settings the service cannot run without and optional
+// features that are only half configured.
+func Config(cfg configuration.Config) Check {
+	return Check{Name: "config", Run: func(ctx context.Context) []Result {
+		var results []Result
+		var missing []string
+		for _, setting := range [][2]string{
+			{"database.psql.host", cfg.Database.Psql.Host},
+			{"database.psql.port", cfg.Database.Psql.Port},
+			{"database.psql.user", cfg.Database.Psql.User},
+		} {
+			if setting[1] == "" {
+				missing = append(missing, setting[0])
+			}
+		}
+		if len(missing) > 0 {
+			results = append(results, Result{"config: database", Fail, "missing " + strings.Join(missing, ", ")})
+		} else {
+			results = append(results, Result{"config: database", OK, ""})
+		}
+
+		if cfg.App.SecretKey == "" || os.Getenv("SECRET_KEY") == "" {
+			results = append(results, Result{"config: secret key", Fail, "app.secretKey and SECRET_KEY must both be set"})
+		} else if cfg.App.SecretKey != os.Getenv("SECRET_KEY") {
+			results = append(results, Result{"config: secret key", Fail, "app.secretKey and SECRET_KEY differ, issued tokens will not verify"})
+		} else {
+			results = append(results, Result{"config: secret key", OK, ""})
+		}
+
+		if cfg.Captcha.Enabled && cfg.Captcha.SecretKey == "" {
+			results = append(results, Result{"config: captcha", Warn, "enabled without secretKey, captcha stays off"})
+		}
+		if provider := cfg.OIDC.Google; provider.ClientID != "" && (provider.ClientSecret == "" || provider.RedirectURL == "") {
+			results = append(results, Result{"config: oidc google", Warn, "clientId set without clientSecret or redirectUrl"})
+		}
+		if provider := cfg.OIDC.Microsoft; provider.ClientID != "" && (provider.ClientSecret == "" || provider.RedirectURL == "") {
+			results = append(results, Result{"config: oidc microsoft", Warn, "clientId set without clientSecret or redirectUrl"})
+		}
+		return results
+	}}
+}
+
+var changesetPattern = regexp.MustCompile(`^--changeset\s+([^\s:]+):(\S+)`)
+
+// Changesets lists the ids declared in a liquibase formatted SQL changelog.
+func Changesets(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var ids []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if match := changesetPattern.FindStringSubmatch(scanner.Text()); match != nil {
+			ids = append(ids, match[2])
+		}
+	}
+	return ids, scanner.Err()
+}
+
+// Database pings Postgres and compares the changesets liquibase has applied
+// with the ones in the changelog shipped with this build.
+func Database(db *sql.DB, openErr error, changelogPath string) Check {
+	return Check{Name: "database", Run: func(ctx context.Context) []Result {
+		if openErr != nil {
+			return []Result{{"database", Fail, openErr.Error()}}
+		}
+		if err := db.PingContext(ctx); err != nil {
+			return []Result{{"database", Fail, err.Error()}}
+		}
+		results := []Result{{"database", OK, "connected"}}
+
+		expected, err := Changesets(changelogPath)
+		if err != nil {
+			return append(results, Result{"database: schema", Skip, err.Error()})
+		}
+
+		rows, err := db.QueryContext(ctx, `SELECT id FROM public.databasechangelog`)
+		if err != nil {
+			return append(results, Result{"database: schema", Fail, "cannot read databasechangelog: " + err.Error()})
+		}
+		defer rows.Close()
+
+		applied := make(map[string]bool)
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return append(results, Result{"database: schema", Fail, err.Error()})
+			}
+			applied[id] = true
+		}
+		if err := rows.Err(); err != nil {
+			return append(results, Result{"database: schema", Fail, err.Error()})
+		}
+
+		var pending []string
+		for _, id := range expected {
+			if !applied[id] {
+				pending = append(pending, id)
+			}
+		}
+		if len(pending) > 0 {
+			return append(results, Result{"database: schema", Fail, "pending changesets " + strings.Join(pending, ", ")})
+		}
+		return append(results, Result{"database: schema", OK, fmt.Sprintf("%d changesets applied", len(expected))})
+	}}
+}
+
+// OIDC asks every configured provider whether it accepts the client
+// credentials.
+func OIDC(providers map[string]oidc.IProvider) Check {
+	return Check{Name: "oidc", Run: func(ctx context.Context) []Result {
+		if len(providers) == 0 {
+			return []Result{{"oidc", Skip, "no providers configured"}}
+		}
+		names := make([]string, 0, len(providers))
+		for name := range providers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var results []Result
+		for _, name := range names {
+			if err := providers[name].CheckCredentials(ctx); err != nil {
+				results = append(results, Result{"oidc: " + name, Fail, err.Error()})
+				continue
+			}
+			results = append(results, Result{"oidc: " + name, OK, "client credentials accepted"})
+		}
+		return results
+	}}
+}
+
+// Redis is optional: the service falls back to in-memory caches without it.
+func Redis(cfg configuration.RedisClient) Check {
+	return Check{Name: "redis", Run: func(ctx context.Context) []Result {
+		if cfg.Host == "" {
+			return []Result{{"redis", Skip, "not configured"}}
+		}
+		client := redis.NewClient(&redis.Options{
+			Addr:     fmt.Sprintf("%s:%s", cfg.Host, cfg.Port),
+			Username: cfg.Username,
+			Password: cfg.Password,
+		})
+		defer client.Close()
+
+		if err := client.Ping(ctx).Err(); err != nil {
+			return []Result{{"redis", Warn, "unreachable, in-memory caches will be used: " + err.Error()}}
+		}
+		return []Result{{"redis", OK, "connected"}}
+	}}
+}
+
+// Upstream checks that a host answers HTTP at all; any status counts.
+func Upstream(name string, host string) Check {
+	return Check{Name: name, Run: func(ctx context.Context) []Result {
+		if host == "" {
+			return []Result{{name, Skip, "not configured"}}
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, host, nil)
+		if err != nil {
+			return []Result{{name, Fail, err.Error()}}
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return []Result{{name, Fail, err.Error()}}
+		}
+		resp.Body.Close()
+		return []Result{{name, OK, resp.Status}}
+	}}
+}
+
+// Egress checks that every required host answers through the configured
+// egress proxy. Any HTTP status counts; the report names the route taken so
+// a proxy refusing the connection can be told apart from a blocked host.
+func Egress(cfg configuration.Egress) Check {
+	return Check{Name: "egress", Run: func(ctx context.Context) []Result {
+		if len(cfg.RequiredHosts) == 0 {
+			return []Result{{"egress", Skip, "no required hosts configured"}}
+		}
+
+		proxyURL, direct, err := clients.ProxyURL(cfg, "")
+		if err != nil {
+			return []Result{{"egress", Fail, err.Error()}}
+		}
+		route := "via proxy environment"
+		switch {
+		case direct:
+			route = "direct"
+		case proxyURL != nil:
+			route = "via " + proxyURL.Redacted()
+		}
+		httpClient := &http.Client{Transport: &http.Transport{Proxy: clients.ProxyFunc(cfg, "")}}
+
+		var results []Result
+		for _, host := range cfg.RequiredHosts {
+			target := host
+			if !strings.Contains(target, "://") {
+				target = "https://" + target
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodHead, target, nil)
+			if err != nil {
+				results = append(results, Result{host, Fail, err.Error()})
+				continue
+			}
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				results = append(results, Result{host, Fail, fmt.Sprintf("unreachable %s: %v", route, err)})
+				continue
+			}
+			resp.Body.Close()
+			results = append(results, Result{host, OK, fmt.Sprintf("%s %s", resp.Status, route)})
+		}
+		return results
+	}}
+}
@@ -0,0 +1,90 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+type Status int
+
+const (
+	OK Status = iota
+	Warn
+	Fail
+	Skip
+)
+
+func (status Status) String() string {
+	switch status {
+	case OK:
+		return "OK"
+	case Warn:
+		return "WARN"
+	case Fail:
+		return "FAIL"
+	}
+	return "SKIP"
+}
+
+func (status Status) color() string {
+	switch status {
+	case OK:
+		return "\033[32m"
+	case Warn:
+		return "\033[33m"
+	case Fail:
+		return "\033[31m"
+	}
+	return "\033[90m"
+}
+
+// Result is the outcome of one check. A check may report several results,
+// e.g. one per configured provider.
+type Result struct {
+	Name   string
+	Status Status
+	Detail string
+}
+
+// Check inspects one dependency of the service.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) []Result
+}
+
+// Run executes the checks in order, giving each one timeout to finish.
+func Run(ctx context.Context, checks []Check, timeout time.Duration) []Result {
+	var results []Result
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		results = append(results, check.Run(checkCtx)...)
+		cancel()
+	}
+	return results
+}
+
+// Failed reports whether any result failed. Warnings do not block a deploy.
+func Failed(results []Result) bool {
+	for _, result := range results {
+		if result.Status == Fail {
+			return true
+		}
+	}
+	return false
+}
+
+// Report prints one line per result, colored by status when color is set.
+func Report(w io.Writer, results []Result, color bool) {
+	counts := make(map[Status]int)
+	for _, result := range results {
+		counts[result.Status]++
+		status := fmt.Sprintf("%-4s", result.Status)
+		if color {
+			status = result.Status.color() + status + "\033[0m"
+		}
+		fmt.Fprintf(w, "[%s] %-24s %s\n", status, result.Name, result.Detail)
+	}
+	fmt.Fprintf(w, "\n%d ok, %d warnings, %d failed, %d skipped\n", counts[OK], counts[Warn], counts[Fail], counts[Skip])
+}
@@ -0,0 +1,92 @@
+package doctor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"my-project/infrastructure/configuration"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeChangelog(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "changelog.sql")
+	content := "--liquibase formatted sql\n\n--changeset lamboktulus1379:1 labels:x\ncreate table a (id int)\n\n--changeset lamboktulus1379:2 labels:x\ncreate table b (id int)\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestChangesets(t *testing.T) {
+	ids, err := Changesets(writeChangelog(t))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, ids)
+}
+
+func TestDatabasePendingChangesets(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectPing()
+	mock.ExpectQuery(`SELECT id FROM public.databasechangelog`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+
+	results := Run(context.Background(), []Check{Database(db, nil, writeChangelog(t))}, time.Second)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, OK, results[0].Status)
+	assert.Equal(t, Fail, results[1].Status)
+	assert.Equal(t, "pending changesets 2", results[1].Detail)
+	assert.True(t, Failed(results))
+}
+
+func TestDatabaseOpenError(t *testing.T) {
+	results := Run(context.Background(), []Check{Database(nil, errors.New("bad port"), "")}, time.Second)
+
+	assert.Equal(t, []Result{{"database", Fail, "bad port"}}, results)
+}
+
+func TestReport(t *testing.T) {
+	var out bytes.Buffer
+	Report(&out, []Result{{"redis", Warn, "unreachable"}, {"oidc", Skip, "no providers configured"}}, false)
+
+	assert.Equal(t, "[WARN] redis                    unreachable\n[SKIP] oidc                     no providers configured\n\n0 ok, 1 warnings, 0 failed, 1 skipped\n", out.String())
+}
+
+func TestEgressThroughProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Host)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	results := Run(context.Background(), []Check{Egress(configuration.Egress{
+		Proxy:         proxy.URL,
+		RequiredHosts: []string{"http://googleapis.invalid"},
+	})}, time.Second)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, OK, results[0].Status)
+	assert.Equal(t, "204 No Content via "+proxy.URL, results[0].Detail)
+	assert.Equal(t, []string{"googleapis.invalid"}, proxied)
+}
+
+func TestEgressInvalidProxy(t *testing.T) {
+	results := Run(context.Background(), []Check{Egress(configuration.Egress{
+		Proxy:         "ftp://proxy.internal",
+		RequiredHosts: []string{"googleapis.com"},
+	})}, time.Second)
+
+	assert.Equal(t, Fail, results[0].Status)
+	assert.Contains(t, results[0].Detail, "unsupported scheme")
+}
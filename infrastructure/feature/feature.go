@@ -0,0 +1,55 @@
+package feature
+
+import (
+	"errors"
+	"sync"
+)
+
+const (
+	Cache      = "cache"
+	Captcha    = "captcha"
+	PubSub     = "pubsub"
+	ServiceBus = "service_bus"
+	TulusTech  = "tulus_tech"
+)
+
+// ErrDisabled is returned by clients whose backing service was not configured
+// or could not be initialised at startup.
+var ErrDisabled = errors.New("feature_disabled")
+
+type IFeature interface {
+	Enabled(name string) bool
+	All() map[string]bool
+}
+
+type Registry struct {
+	mu       sync.RWMutex
+	features map[string]bool
+}
+
+func NewRegistry() *Registry {
+	return &Registry{features: make(map[string]bool)}
+}
+
+func (registry *Registry) Set(name string, enabled bool) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.features[name] = enabled
+}
+
+func (registry *Registry) Enabled(name string) bool {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	return registry.features[name]
+}
+
+func (registry *Registry) All() map[string]bool {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	features := make(map[string]bool, len(registry.features))
+	for name, enabled := range registry.features {
+		features[name] = enabled
+	}
+	return features
+}
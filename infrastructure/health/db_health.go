@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"my-project/infrastructure/logger"
+	"sync/atomic"
+	"time"
+)
+
+const pingTimeout = 2 * time.Second
+
+type IHealth interface {
+	Healthy() bool
+}
+
+// DBHealth tracks whether the primary database answers pings. It starts out
+// healthy and is refreshed by calling Check, usually from a ticker.
+type DBHealth struct {
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+func NewDBHealth(db *sql.DB) *DBHealth {
+	dbHealth := &DBHealth{db: db}
+	dbHealth.healthy.Store(true)
+	return dbHealth
+}
+
+func (dbHealth *DBHealth) Healthy() bool {
+	return dbHealth.healthy.Load()
+}
+
+func (dbHealth *DBHealth) Check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	err := dbHealth.db.PingContext(ctx)
+	healthy := err == nil
+	if dbHealth.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		logger.GetLogger().Info("Database reachable again, leaving read-only mode")
+	} else {
+		logger.GetLogger().WithField("error", err).Error("Database unreachable, switching to read-only mode")
+	}
+}
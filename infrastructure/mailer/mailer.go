@@ -0,0 +1,51 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"my-project/infrastructure/configuration"
+	"net/smtp"
+	"strings"
+)
+
+var ErrInvalidHeader = errors.New("mail header contains a line break")
+
+type IMailer interface {
+	Send(ctx context.Context, to string, subject string, body string) error
+}
+
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewMailer returns nil when no SMTP host is configured, so callers can skip
+// sending.
+func NewMailer(cfg configuration.Mail) IMailer {
+	if cfg.Host == "" {
+		return nil
+	}
+	mailer := &SMTPMailer{addr: fmt.Sprintf("%s:%d", cfg.Host, cfg.Port), from: cfg.From}
+	if cfg.Username != "" {
+		mailer.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return mailer
+}
+
+// Send delivers a plain text message. net/smtp has no context support, so
+// ctx is only checked before connecting.
+func (mailer *SMTPMailer) Send(ctx context.Context, to string, subject string, body string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, header := range []string{mailer.from, to, subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return ErrInvalidHeader
+		}
+	}
+
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s", mailer.from, to, subject, body)
+	return smtp.SendMail(mailer.addr, mailer.auth, mailer.from, []string{to}, []byte(message))
+}
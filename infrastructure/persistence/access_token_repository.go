@@ -0,0 +1,143 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"strings"
+)
+
+type AccessTokenRepository struct {
+	statements *StatementCache
+}
+
+func NewAccessTokenRepository(sqlDB *sql.DB) repository.IAccessToken {
+	return &AccessTokenRepository{statements: NewStatementCache(sqlDB)}
+}
+
+func (accessTokenRepository *AccessTokenRepository) CreateToken(ctx context.Context, token model.AccessToken) (model.AccessToken, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := accessTokenRepository.statements.PrepareContext(ctx, `INSERT INTO public.personal_access_tokens (user_id, name, prefix, token_hash, scopes, expires_at) VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id, created_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return token, err
+	}
+
+	err = statement.QueryRowContext(ctx, token.UserID, token.Name, token.Prefix, token.TokenHash, strings.Join(token.Scopes, ","), token.ExpiresAt).Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return token, err
+	}
+
+	return token, nil
+}
+
+// GetTokensByUserId returns the tokens that have not been revoked, including
+// expired ones, newest first.
+func (accessTokenRepository *AccessTokenRepository) GetTokensByUserId(ctx context.Context, userId int64) ([]model.AccessToken, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tokens := []model.AccessToken{}
+	statement, err := accessTokenRepository.statements.PrepareContext(ctx, `SELECT t.id, t.user_id, t.name, t.prefix, t.scopes, t.created_at, t.expires_at, t.last_used_at
+	FROM public.personal_access_tokens AS t
+	WHERE t.user_id = $1 AND t.revoked_at IS NULL
+	ORDER BY t.created_at DESC`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return tokens, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return tokens, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var token model.AccessToken
+		var scopes string
+		err = rows.Scan(&token.ID, &token.UserID, &token.Name, &token.Prefix, &scopes, &token.CreatedAt, &token.ExpiresAt, &token.LastUsedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return tokens, err
+		}
+		token.Scopes = strings.Split(scopes, ",")
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// GetTokenByHash only finds tokens that are neither revoked nor expired.
+func (accessTokenRepository *AccessTokenRepository) GetTokenByHash(ctx context.Context, tokenHash string) (model.AccessToken, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var token model.AccessToken
+	statement, err := accessTokenRepository.statements.PrepareContext(ctx, `SELECT t.id, t.user_id, t.name, t.prefix, t.scopes, t.created_at, t.expires_at, t.last_used_at
+	FROM public.personal_access_tokens AS t
+	WHERE t.token_hash = $1 AND t.revoked_at IS NULL AND (t.expires_at IS NULL OR t.expires_at > NOW())`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return token, err
+	}
+
+	var scopes string
+	err = statement.QueryRowContext(ctx, tokenHash).Scan(&token.ID, &token.UserID, &token.Name, &token.Prefix, &scopes, &token.CreatedAt, &token.ExpiresAt, &token.LastUsedAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return token, err
+	}
+	token.Scopes = strings.Split(scopes, ",")
+
+	return token, nil
+}
+
+// TouchToken records that a token was used. Like TouchSession, writes are
+// skipped while the stored timestamp is less than a minute old.
+func (accessTokenRepository *AccessTokenRepository) TouchToken(ctx context.Context, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := accessTokenRepository.statements.PrepareContext(ctx, `UPDATE public.personal_access_tokens SET last_used_at = NOW()
+	WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	_, err = statement.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (accessTokenRepository *AccessTokenRepository) RevokeToken(ctx context.Context, userId int64, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := accessTokenRepository.statements.PrepareContext(ctx, `UPDATE public.personal_access_tokens SET revoked_at = NOW()
+	WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, id, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
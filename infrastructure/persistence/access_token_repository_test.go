@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenRepository_GetTokenByHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`WHERE t.token_hash = $1 AND t.revoked_at IS NULL`))
+	prep.ExpectQuery().WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "prefix", "scopes", "created_at", "expires_at", "last_used_at"}).
+			AddRow(3, 1, "CI", "pat_abcdefgh", "read:notes,write:notes", createdAt, nil, nil))
+
+	token, err := NewAccessTokenRepository(db).GetTokenByHash(context.Background(), "hash")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3), token.ID)
+	require.Equal(t, []string{"read:notes", "write:notes"}, token.Scopes)
+	require.Nil(t, token.ExpiresAt)
+}
+
+func TestAccessTokenRepository_RevokeTokenNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`UPDATE public.personal_access_tokens SET revoked_at = NOW()`))
+	prep.ExpectExec().WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewAccessTokenRepository(db).RevokeToken(context.Background(), 1, 3)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type BookmarkRepository struct {
+	statements *StatementCache
+}
+
+func NewBookmarkRepository(sqlDB *sql.DB) repository.IBookmark {
+	return &BookmarkRepository{statements: NewStatementCache(sqlDB)}
+}
+
+func (bookmarkRepository *BookmarkRepository) CreateBookmark(ctx context.Context, bookmark model.VideoBookmark) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := bookmarkRepository.statements.PrepareContext(ctx, `INSERT INTO public.user_video_bookmarks (user_id, video_id) VALUES ($1, $2)
+	ON CONFLICT (user_id, video_id) DO NOTHING`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	_, err = statement.ExecContext(ctx, bookmark.UserID, bookmark.VideoID)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (bookmarkRepository *BookmarkRepository) DeleteBookmark(ctx context.Context, userId int64, videoId string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := bookmarkRepository.statements.PrepareContext(ctx, `DELETE FROM public.user_video_bookmarks WHERE user_id = $1 AND video_id = $2`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, userId, videoId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+func (bookmarkRepository *BookmarkRepository) GetBookmarksByUserId(ctx context.Context, userId int64) ([]model.VideoBookmark, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	bookmarks := []model.VideoBookmark{}
+	statement, err := bookmarkRepository.statements.PrepareContext(ctx, `SELECT b.id, b.user_id, b.video_id, b.created_at
+	FROM public.user_video_bookmarks AS b
+	WHERE b.user_id = $1
+	ORDER BY b.created_at DESC`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return bookmarks, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return bookmarks, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bookmark model.VideoBookmark
+		err = rows.Scan(&bookmark.ID, &bookmark.UserID, &bookmark.VideoID, &bookmark.CreatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return bookmarks, err
+		}
+		bookmarks = append(bookmarks, bookmark)
+	}
+
+	return bookmarks, rows.Err()
+}
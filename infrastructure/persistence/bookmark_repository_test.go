@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookmarkRepository_CreateBookmark(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user_video_bookmarks (user_id, video_id) VALUES ($1, $2)
+	ON CONFLICT (user_id, video_id) DO NOTHING`))
+	prep.ExpectExec().WithArgs(1, "dQw4w9WgXcQ").WillReturnResult(sqlmock.NewResult(1, 1))
+	// Bookmarking the same video again is not an error.
+	prep.ExpectExec().WithArgs(1, "dQw4w9WgXcQ").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repository := NewBookmarkRepository(db)
+	bookmark := model.VideoBookmark{UserID: 1, VideoID: "dQw4w9WgXcQ"}
+	require.NoError(t, repository.CreateBookmark(context.Background(), bookmark))
+	require.NoError(t, repository.CreateBookmark(context.Background(), bookmark))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBookmarkRepository_DeleteBookmark(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`DELETE FROM public.user_video_bookmarks WHERE user_id = $1 AND video_id = $2`))
+	prep.ExpectExec().WithArgs(1, "dQw4w9WgXcQ").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewBookmarkRepository(db).DeleteBookmark(context.Background(), 1, "dQw4w9WgXcQ")
+
+	require.NoError(t, err)
+}
+
+func TestBookmarkRepository_DeleteBookmarkNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`DELETE FROM public.user_video_bookmarks WHERE user_id = $1 AND video_id = $2`))
+	prep.ExpectExec().WithArgs(1, "dQw4w9WgXcQ").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewBookmarkRepository(db).DeleteBookmark(context.Background(), 1, "dQw4w9WgXcQ")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestBookmarkRepository_GetBookmarksByUserId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2023, 9, 4, 1, 2, 10, 0, time.UTC)
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`SELECT b.id, b.user_id, b.video_id, b.created_at`))
+	prep.ExpectQuery().WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "video_id", "created_at"}).AddRow(3, 1, "dQw4w9WgXcQ", createdAt))
+
+	bookmarks, err := NewBookmarkRepository(db).GetBookmarksByUserId(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.Equal(t, []model.VideoBookmark{{ID: 3, UserID: 1, VideoID: "dQw4w9WgXcQ", CreatedAt: createdAt}}, bookmarks)
+}
@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"context"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/logger"
+)
+
+// CachedUserRepository serves GetByUserName from a short-lived cache. It is
+// meant for the auth middleware: returned users never include the password
+// hash, so login must keep using the plain repository.
+type CachedUserRepository struct {
+	repository.IUser
+	userCache cache.IUserCache
+}
+
+func NewCachedUserRepository(userRepository repository.IUser, userCache cache.IUserCache) repository.IUser {
+	return &CachedUserRepository{IUser: userRepository, userCache: userCache}
+}
+
+func (cachedUserRepository *CachedUserRepository) GetByUserName(ctx context.Context, userName string) (model.User, error) {
+	if user, ok := cachedUserRepository.userCache.Get(ctx, userName); ok {
+		return user, nil
+	}
+
+	user, err := cachedUserRepository.IUser.GetByUserName(ctx, userName)
+	if err != nil {
+		return user, err
+	}
+	cachedUserRepository.userCache.Set(ctx, user)
+	user.Password = ""
+	return user, nil
+}
+
+func (cachedUserRepository *CachedUserRepository) CreateUser(ctx context.Context, user model.User) error {
+	cachedUserRepository.userCache.Invalidate(ctx, user.UserName)
+	return cachedUserRepository.IUser.CreateUser(ctx, user)
+}
+
+// UpdateStatus evicts the user once the status has changed so the auth
+// middleware does not keep admitting a rejected account until the entry
+// expires. The cache is keyed by user name, so the user is looked up first.
+func (cachedUserRepository *CachedUserRepository) UpdateStatus(ctx context.Context, id int64, from string, to string) error {
+	if err := cachedUserRepository.IUser.UpdateStatus(ctx, id, from, to); err != nil {
+		return err
+	}
+
+	user, err := cachedUserRepository.IUser.GetById(ctx, int(id))
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("user_id", id).Error("Error while get user to invalidate cache")
+		return nil
+	}
+	cachedUserRepository.userCache.Invalidate(ctx, user.UserName)
+	return nil
+}
@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"context"
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"my-project/mocks/repomocks"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedUserRepository_GetByUserName(t *testing.T) {
+	userRepository := repomocks.NewIUser(t)
+	userRepository.On("GetByUserName", context.Background(), "lamboktulus1379").Return(model.User{
+		ID:       1,
+		UserName: "lamboktulus1379",
+		Password: "a252f77af72638ea5a0f9e5fbe5f2b2e",
+	}, nil).Once()
+
+	cachedUserRepository := NewCachedUserRepository(userRepository, cache.NewUserCache(nil))
+	for i := 0; i < 3; i++ {
+		user, err := cachedUserRepository.GetByUserName(context.Background(), "lamboktulus1379")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), user.ID)
+		require.Empty(t, user.Password)
+	}
+}
+
+func TestCachedUserRepository_CreateUserInvalidates(t *testing.T) {
+	userCache := cache.NewUserCache(nil)
+	userCache.Set(context.Background(), model.User{ID: 1, UserName: "lamboktulus1379"})
+	userRepository := repomocks.NewIUser(t)
+	userRepository.On("CreateUser", context.Background(), model.User{UserName: "lamboktulus1379"}).Return(nil).Once()
+
+	err := NewCachedUserRepository(userRepository, userCache).CreateUser(context.Background(), model.User{UserName: "lamboktulus1379"})
+
+	require.NoError(t, err)
+	_, cached := userCache.Get(context.Background(), "lamboktulus1379")
+	require.False(t, cached)
+}
+
+func TestCachedUserRepository_UpdateStatusInvalidates(t *testing.T) {
+	userCache := cache.NewUserCache(nil)
+	userCache.Set(context.Background(), model.User{ID: 1, UserName: "lamboktulus1379", Status: model.UserStatusPending})
+	userRepository := repomocks.NewIUser(t)
+	userRepository.On("UpdateStatus", context.Background(), int64(1), model.UserStatusPending, model.UserStatusRejected).Return(nil).Once()
+	userRepository.On("GetById", context.Background(), 1).Return(model.User{ID: 1, UserName: "lamboktulus1379"}, nil).Once()
+
+	err := NewCachedUserRepository(userRepository, userCache).UpdateStatus(context.Background(), 1, model.UserStatusPending, model.UserStatusRejected)
+
+	require.NoError(t, err)
+	_, cached := userCache.Get(context.Background(), "lamboktulus1379")
+	require.False(t, cached)
+}
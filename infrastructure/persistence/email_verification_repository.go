@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type EmailVerificationRepository struct {
+	sqlDB      *sql.DB
+	statements *StatementCache
+}
+
+func NewEmailVerificationRepository(sqlDB *sql.DB) repository.IEmailVerification {
+	return &EmailVerificationRepository{sqlDB: sqlDB, statements: NewStatementCache(sqlDB)}
+}
+
+// CreateUserWithVerification stores a new account without an email address
+// and the pending verification of that address in one transaction, and
+// returns the account id.
+func (emailVerificationRepository *EmailVerificationRepository) CreateUserWithVerification(ctx context.Context, user model.User, verification model.EmailVerification) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	tx, err := emailVerificationRepository.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while begin transaction")
+		return id, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `INSERT INTO public.user (name, user_name, password, status) VALUES ($1, $2, $3, $4) RETURNING id`,
+		user.Name, user.UserName, user.Password, user.Status).Scan(&id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO public.email_verifications (user_id, email, token_hash, expires_at) VALUES ($1, $2, $3, $4)`,
+		id, verification.Email, verification.TokenHash, verification.ExpiresAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while commit transaction")
+		return id, err
+	}
+
+	return id, nil
+}
+
+// GetVerificationByHash returns sql.ErrNoRows when the token is unknown or
+// has expired.
+func (emailVerificationRepository *EmailVerificationRepository) GetVerificationByHash(ctx context.Context, tokenHash string) (model.EmailVerification, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var verification model.EmailVerification
+	statement, err := emailVerificationRepository.statements.PrepareContext(ctx, `SELECT v.id, v.user_id, v.email, v.token_hash, v.created_at, v.expires_at
+	FROM public.email_verifications AS v
+	WHERE v.token_hash = $1 AND v.expires_at > NOW()`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return verification, err
+	}
+
+	err = statement.QueryRowContext(ctx, tokenHash).Scan(&verification.ID, &verification.UserID, &verification.Email, &verification.TokenHash, &verification.CreatedAt, &verification.ExpiresAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return verification, err
+	}
+
+	return verification, nil
+}
+
+// ConfirmEmail stores the verified address on the user and drops every
+// pending verification of that user.
+func (emailVerificationRepository *EmailVerificationRepository) ConfirmEmail(ctx context.Context, verification model.EmailVerification) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := emailVerificationRepository.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while begin transaction")
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE public.user SET email = $1, updated_at = NOW() WHERE id = $2`, verification.Email, verification.UserID)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+	if err = requireAffected(result); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM public.email_verifications WHERE user_id = $1`, verification.UserID)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while commit transaction")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailVerificationRepository_ConfirmEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE public.user SET email = $1, updated_at = NOW() WHERE id = $2`)).
+		WithArgs("lambok@tulus.tech", 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.email_verifications WHERE user_id = $1`)).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = NewEmailVerificationRepository(db).ConfirmEmail(context.Background(), model.EmailVerification{UserID: 4, Email: "lambok@tulus.tech"})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmailVerificationRepository_GetVerificationByHashExpired(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`WHERE v.token_hash = $1 AND v.expires_at > NOW()`))
+	prep.ExpectQuery().WithArgs("hash").WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "email", "token_hash", "created_at", "expires_at"}))
+
+	_, err = NewEmailVerificationRepository(db).GetVerificationByHash(context.Background(), "hash")
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
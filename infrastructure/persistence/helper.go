@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+// requireAffected returns sql.ErrNoRows when a statement matched nothing, so
+// callers can tell a missing record apart from a successful update or delete.
+func requireAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get rows affected")
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// withQueryTimeout bounds a repository call. A context that already carries a
+// deadline keeps it, otherwise the configured default applies; either way the
+// call never runs longer than the configured maximum. Zero disables a bound.
+func withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	cfg := configuration.C.Database.QueryTimeout
+	timeout := time.Duration(cfg.DefaultMs) * time.Millisecond
+	if _, ok := ctx.Deadline(); ok {
+		timeout = 0
+	}
+	if max := time.Duration(cfg.MaxMs) * time.Millisecond; max > 0 && (timeout <= 0 || timeout > max) {
+		timeout = max
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
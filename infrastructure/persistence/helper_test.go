@@ -0,0 +1,44 @@
+package persistence
+
+import (
+	"context"
+	"my-project/infrastructure/configuration"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithQueryTimeout(t *testing.T) {
+	previous := configuration.C.Database.QueryTimeout
+	t.Cleanup(func() { configuration.C.Database.QueryTimeout = previous })
+	configuration.C.Database.QueryTimeout = configuration.QueryTimeout{DefaultMs: 1000, MaxMs: 5000}
+
+	remaining := func(ctx context.Context) time.Duration {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		return time.Until(deadline)
+	}
+
+	ctx, cancel := withQueryTimeout(context.Background())
+	defer cancel()
+	require.InDelta(t, time.Second, remaining(ctx), float64(100*time.Millisecond))
+
+	parent, cancelParent := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancelParent()
+	ctx, cancel = withQueryTimeout(parent)
+	defer cancel()
+	require.InDelta(t, 3*time.Second, remaining(ctx), float64(100*time.Millisecond))
+
+	parent, cancelParent = context.WithTimeout(context.Background(), time.Minute)
+	defer cancelParent()
+	ctx, cancel = withQueryTimeout(parent)
+	defer cancel()
+	require.InDelta(t, 5*time.Second, remaining(ctx), float64(100*time.Millisecond))
+
+	configuration.C.Database.QueryTimeout = configuration.QueryTimeout{}
+	ctx, cancel = withQueryTimeout(context.Background())
+	defer cancel()
+	_, ok := ctx.Deadline()
+	require.False(t, ok)
+}
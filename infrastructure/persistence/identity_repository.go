@@ -0,0 +1,134 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type IdentityRepository struct {
+	sqlDB      *sql.DB
+	statements *StatementCache
+}
+
+func NewIdentityRepository(sqlDB *sql.DB) repository.IIdentity {
+	return &IdentityRepository{sqlDB: sqlDB, statements: NewStatementCache(sqlDB)}
+}
+
+// GetUserByIdentity returns the account linked to the provider subject, or
+// sql.ErrNoRows when the subject has not signed in before.
+func (identityRepository *IdentityRepository) GetUserByIdentity(ctx context.Context, provider string, subject string) (model.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var user model.User
+	statement, err := identityRepository.statements.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, COALESCE(u.email, ''), u.status, u.created_at, u.updated_at
+	FROM public.user_identities AS i
+	JOIN public.user AS u ON u.id = i.user_id
+	WHERE i.provider = $1 AND i.subject = $2`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return user, err
+	}
+
+	err = statement.QueryRowContext(ctx, provider, subject).Scan(&user.ID, &user.Name, &user.UserName, &user.Email, &user.Status, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return user, err
+	}
+
+	return user, nil
+}
+
+// CreateUserWithIdentity stores a new account and its identity in one
+// transaction and returns the account id.
+func (identityRepository *IdentityRepository) CreateUserWithIdentity(ctx context.Context, user model.User, provider string, subject string) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	tx, err := identityRepository.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while begin transaction")
+		return id, err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `INSERT INTO public.user (name, user_name, email, status) VALUES ($1, $2, NULLIF($3, ''), $4) RETURNING id`,
+		user.Name, user.UserName, user.Email, user.Status).Scan(&id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO public.user_identities (user_id, provider, subject) VALUES ($1, $2, $3)`, id, provider, subject)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while commit transaction")
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (identityRepository *IdentityRepository) GetIdentitiesByUserId(ctx context.Context, userId int64) ([]model.UserIdentity, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	identities := []model.UserIdentity{}
+	statement, err := identityRepository.statements.PrepareContext(ctx, `SELECT i.id, i.user_id, i.provider, i.subject, i.created_at
+	FROM public.user_identities AS i
+	WHERE i.user_id = $1
+	ORDER BY i.created_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return identities, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return identities, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var identity model.UserIdentity
+		err = rows.Scan(&identity.ID, &identity.UserID, &identity.Provider, &identity.Subject, &identity.CreatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return identities, err
+		}
+		identities = append(identities, identity)
+	}
+
+	return identities, rows.Err()
+}
+
+// LinkIdentity adds a provider subject to an existing account.
+func (identityRepository *IdentityRepository) LinkIdentity(ctx context.Context, userId int64, provider string, subject string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := identityRepository.statements.PrepareContext(ctx, `INSERT INTO public.user_identities (user_id, provider, subject) VALUES ($1, $2, $3)
+	ON CONFLICT (provider, subject) DO NOTHING`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, userId, provider, subject)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
@@ -0,0 +1,70 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityRepository_CreateUserWithIdentity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, email, status)`)).
+		WithArgs("Lambok", "google:1234", "", model.UserStatusActive).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.user_identities (user_id, provider, subject)`)).
+		WithArgs(4, "google", "1234").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	id, err := NewIdentityRepository(db).CreateUserWithIdentity(context.Background(), model.User{
+		Name:     "Lambok",
+		UserName: "google:1234",
+		Status:   model.UserStatusActive,
+	}, "google", "1234")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(4), id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdentityRepository_CreateUserWithIdentityRollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, email, status)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.user_identities (user_id, provider, subject)`)).
+		WillReturnError(errors.New("duplicate key"))
+	mock.ExpectRollback()
+
+	_, err = NewIdentityRepository(db).CreateUserWithIdentity(context.Background(), model.User{Name: "Lambok"}, "google", "1234")
+
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIdentityRepository_LinkIdentityAlreadyLinked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user_identities (user_id, provider, subject) VALUES ($1, $2, $3)`))
+	prep.ExpectExec().WithArgs(1, "google", "1234").WillReturnResult(sqlmock.NewResult(0, 1))
+	prep.ExpectExec().WithArgs(2, "google", "1234").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repository := NewIdentityRepository(db)
+	require.NoError(t, repository.LinkIdentity(context.Background(), 1, "google", "1234"))
+	require.ErrorIs(t, repository.LinkIdentity(context.Background(), 2, "google", "1234"), sql.ErrNoRows)
+}
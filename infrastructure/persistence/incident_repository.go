@@ -0,0 +1,93 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+type IncidentRepository struct {
+	statements *StatementCache
+}
+
+func NewIncidentRepository(sqlDB *sql.DB) repository.IIncident {
+	return &IncidentRepository{statements: NewStatementCache(sqlDB)}
+}
+
+func (incidentRepository *IncidentRepository) CreateIncident(ctx context.Context, incident model.Incident) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	statement, err := incidentRepository.statements.PrepareContext(ctx, `INSERT INTO public.status_incidents (title, message, severity) VALUES ($1, $2, $3) RETURNING id`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return id, err
+	}
+
+	err = statement.QueryRowContext(ctx, incident.Title, incident.Message, incident.Severity).Scan(&id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	return id, nil
+}
+
+// GetIncidentsSince returns the open incidents and those started after since,
+// newest first.
+func (incidentRepository *IncidentRepository) GetIncidentsSince(ctx context.Context, since time.Time) ([]model.Incident, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	incidents := []model.Incident{}
+	statement, err := incidentRepository.statements.PrepareContext(ctx, `SELECT i.id, i.title, i.message, i.severity, i.started_at, i.resolved_at
+	FROM public.status_incidents AS i
+	WHERE i.resolved_at IS NULL OR i.started_at >= $1
+	ORDER BY i.started_at DESC`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return incidents, err
+	}
+
+	rows, err := statement.QueryContext(ctx, since)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return incidents, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var incident model.Incident
+		err = rows.Scan(&incident.ID, &incident.Title, &incident.Message, &incident.Severity, &incident.StartedAt, &incident.ResolvedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return incidents, err
+		}
+		incidents = append(incidents, incident)
+	}
+
+	return incidents, rows.Err()
+}
+
+func (incidentRepository *IncidentRepository) ResolveIncident(ctx context.Context, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := incidentRepository.statements.PrepareContext(ctx, `UPDATE public.status_incidents SET resolved_at = NOW() WHERE id = $1 AND resolved_at IS NULL`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentRepository_GetIncidentsSince(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolvedAt := since.Add(2 * time.Hour)
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`FROM public.status_incidents AS i`))
+	prep.ExpectQuery().WithArgs(since).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "message", "severity", "started_at", "resolved_at"}).
+			AddRow(2, "Login errors", "", "major", since.Add(3*time.Hour), nil).
+			AddRow(1, "Database upgrade", "", "maintenance", since.Add(time.Hour), resolvedAt))
+
+	incidents, err := NewIncidentRepository(db).GetIncidentsSince(context.Background(), since)
+
+	require.NoError(t, err)
+	require.Len(t, incidents, 2)
+	require.Nil(t, incidents[0].ResolvedAt)
+	require.Equal(t, resolvedAt, *incidents[1].ResolvedAt)
+}
+
+func TestIncidentRepository_ResolveIncidentNotOpen(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`UPDATE public.status_incidents SET resolved_at = NOW()`))
+	prep.ExpectExec().WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewIncidentRepository(db).ResolveIncident(context.Background(), 7)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,128 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+)
+
+// benchmarkRows is the size of the listings the benchmarks below scan.
+const benchmarkRows = 10000
+
+// rowsDriver answers every query with benchmarkRows generated rows. The data
+// source name picks the row shape, so each table gets its own sql.DB.
+type rowsDriver struct{}
+
+func (rowsDriver) Open(name string) (driver.Conn, error) { return rowsConn{shape: name}, nil }
+
+type rowsConn struct{ shape string }
+
+func (conn rowsConn) Prepare(query string) (driver.Stmt, error) {
+	return rowsStmt{shape: conn.shape}, nil
+}
+func (rowsConn) Close() error              { return nil }
+func (rowsConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type rowsStmt struct{ shape string }
+
+func (rowsStmt) Close() error  { return nil }
+func (rowsStmt) NumInput() int { return -1 }
+func (rowsStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+func (stmt rowsStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &generatedRows{shape: stmt.shape, now: time.Now()}, nil
+}
+
+type generatedRows struct {
+	shape string
+	n     int
+	now   time.Time
+}
+
+func (rows *generatedRows) Columns() []string {
+	switch rows.shape {
+	case "search_history":
+		return []string{"id", "user_id", "query", "created_at"}
+	case "user_video_bookmarks":
+		return []string{"id", "user_id", "video_id", "created_at"}
+	default:
+		return []string{"id", "video_id", "user_id", "body", "created_at", "updated_at"}
+	}
+}
+func (*generatedRows) Close() error { return nil }
+func (rows *generatedRows) Next(dest []driver.Value) error {
+	if rows.n == benchmarkRows {
+		return io.EOF
+	}
+	rows.n++
+	id := int64(rows.n)
+	switch rows.shape {
+	case "search_history":
+		copy(dest, []driver.Value{id, int64(1), fmt.Sprintf("golang tutorial %d", id), rows.now})
+	case "user_video_bookmarks":
+		copy(dest, []driver.Value{id, int64(1), fmt.Sprintf("video%07d", id), rows.now})
+	default:
+		copy(dest, []driver.Value{id, "dQw4w9WgXcQ", int64(1), "Add chapters for the intro and the outro", rows.now, rows.now})
+	}
+	return nil
+}
+
+func init() {
+	sql.Register("rows", rowsDriver{})
+}
+
+func openRowsDB(b *testing.B, shape string) *sql.DB {
+	db, err := sql.Open("rows", shape)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { db.Close() })
+	return db
+}
+
+func BenchmarkSearchRepository_GetHistoryByUserId(b *testing.B) {
+	repository := NewSearchRepository(openRowsDB(b, "search_history"))
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		histories, err := repository.GetHistoryByUserId(ctx, 1, 0)
+		if err != nil || len(histories) != benchmarkRows {
+			b.Fatalf("got %d rows, err %v", len(histories), err)
+		}
+	}
+}
+
+func BenchmarkBookmarkRepository_GetBookmarksByUserId(b *testing.B) {
+	repository := NewBookmarkRepository(openRowsDB(b, "user_video_bookmarks"))
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bookmarks, err := repository.GetBookmarksByUserId(ctx, 1)
+		if err != nil || len(bookmarks) != benchmarkRows {
+			b.Fatalf("got %d rows, err %v", len(bookmarks), err)
+		}
+	}
+}
+
+func BenchmarkNoteRepository_GetNotesByUserId(b *testing.B) {
+	repository := NewNoteRepository(openRowsDB(b, "video_notes"))
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		notes, err := repository.GetNotesByUserId(ctx, 1)
+		if err != nil || len(notes) != benchmarkRows {
+			b.Fatalf("got %d rows, err %v", len(notes), err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type NoteRepository struct {
+	statements *StatementCache
+}
+
+func NewNoteRepository(sqlDB *sql.DB) repository.INote {
+	return &NoteRepository{statements: NewStatementCache(sqlDB)}
+}
+
+func (noteRepository *NoteRepository) CreateNote(ctx context.Context, note model.VideoNote) (model.VideoNote, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := noteRepository.statements.PrepareContext(ctx, `INSERT INTO public.video_notes (video_id, user_id, body) VALUES ($1, $2, $3)
+	RETURNING id, created_at, updated_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return note, err
+	}
+
+	err = statement.QueryRowContext(ctx, note.VideoID, note.UserID, note.Body).Scan(&note.ID, &note.CreatedAt, &note.UpdatedAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return note, err
+	}
+
+	return note, nil
+}
+
+func (noteRepository *NoteRepository) GetNotesByVideoId(ctx context.Context, userId int64, videoId string) ([]model.VideoNote, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	notes := []model.VideoNote{}
+	statement, err := noteRepository.statements.PrepareContext(ctx, `SELECT n.id, n.video_id, n.user_id, n.body, n.created_at, n.updated_at
+	FROM public.video_notes AS n
+	WHERE n.user_id = $1 AND n.video_id = $2
+	ORDER BY n.created_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return notes, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId, videoId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return notes, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var note model.VideoNote
+		err = rows.Scan(&note.ID, &note.VideoID, &note.UserID, &note.Body, &note.CreatedAt, &note.UpdatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return notes, err
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, rows.Err()
+}
+
+func (noteRepository *NoteRepository) GetNotesByUserId(ctx context.Context, userId int64) ([]model.VideoNote, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	notes := []model.VideoNote{}
+	statement, err := noteRepository.statements.PrepareContext(ctx, `SELECT n.id, n.video_id, n.user_id, n.body, n.created_at, n.updated_at
+	FROM public.video_notes AS n
+	WHERE n.user_id = $1
+	ORDER BY n.video_id, n.created_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return notes, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return notes, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var note model.VideoNote
+		err = rows.Scan(&note.ID, &note.VideoID, &note.UserID, &note.Body, &note.CreatedAt, &note.UpdatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return notes, err
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, rows.Err()
+}
+
+func (noteRepository *NoteRepository) UpdateNote(ctx context.Context, note model.VideoNote) (model.VideoNote, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := noteRepository.statements.PrepareContext(ctx, `UPDATE public.video_notes SET body = $1, updated_at = NOW()
+	WHERE id = $2 AND user_id = $3 AND video_id = $4
+	RETURNING created_at, updated_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return note, err
+	}
+
+	err = statement.QueryRowContext(ctx, note.Body, note.ID, note.UserID, note.VideoID).Scan(&note.CreatedAt, &note.UpdatedAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return note, err
+	}
+
+	return note, nil
+}
+
+func (noteRepository *NoteRepository) DeleteNote(ctx context.Context, userId int64, videoId string, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := noteRepository.statements.PrepareContext(ctx, `DELETE FROM public.video_notes WHERE id = $1 AND user_id = $2 AND video_id = $3`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, id, userId, videoId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
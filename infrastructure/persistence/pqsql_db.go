@@ -8,7 +8,7 @@ import (
 	"strconv"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 func NewPostgreSQLDb() (*sql.DB, error) {
@@ -22,11 +22,19 @@ func NewPostgreSQLDb() (*sql.DB, error) {
 
 	dsn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable&search_path=public", cfg.User, cfg.Password, cfg.Host, port, cfg.Name)
 
-	db, err := sql.Open("postgres", dsn)
+	connector, err := pq.NewConnector(dsn)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while connection to postgres")
 		return nil, err
 	}
+
+	var db *sql.DB
+	if queryLog := configuration.C.Database.QueryLog; queryLog.Enabled {
+		threshold := time.Duration(queryLog.SlowThresholdMs) * time.Millisecond
+		db = sql.OpenDB(NewLoggedConnector(connector, NewQueryLogger(threshold, queryLog.LogAll)))
+	} else {
+		db = sql.OpenDB(connector)
+	}
 	db.SetConnMaxIdleTime(20)
 	db.SetMaxIdleConns(10)
 	db.SetConnMaxLifetime(time.Minute * 5)
@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+// userDataStatements delete everything stored for a user, children first so
+// the purge does not depend on the foreign keys cascading.
+var userDataStatements = []string{
+	`DELETE FROM public.search_history WHERE user_id = $1`,
+	`DELETE FROM public.saved_search WHERE user_id = $1`,
+	`DELETE FROM public.user_video_bookmarks WHERE user_id = $1`,
+	`DELETE FROM public.video_notes WHERE user_id = $1`,
+	`DELETE FROM public.user_sessions WHERE user_id = $1`,
+	`DELETE FROM public.api_usage WHERE user_id = $1`,
+	`DELETE FROM public.personal_access_tokens WHERE user_id = $1`,
+	`DELETE FROM public.user_identities WHERE user_id = $1`,
+	`DELETE FROM public.email_verifications WHERE user_id = $1`,
+	`DELETE FROM public.user WHERE id = $1`,
+}
+
+type PrivacyRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewPrivacyRepository(sqlDB *sql.DB) repository.IPrivacy {
+	return &PrivacyRepository{sqlDB}
+}
+
+func (privacyRepository *PrivacyRepository) PurgeUserData(ctx context.Context, userId int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	tx, err := privacyRepository.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while begin transaction")
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, query := range userDataStatements {
+		_, err = tx.ExecContext(ctx, query, userId)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).WithField("query", query).Error("Error execute query")
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while commit transaction")
+		return err
+	}
+
+	return nil
+}
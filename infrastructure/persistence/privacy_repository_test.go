@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivacyRepository_PurgeUserData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	for _, query := range userDataStatements {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	err = NewPrivacyRepository(db).PurgeUserData(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPrivacyRepository_PurgeUserDataRollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(userDataStatements[0])).WithArgs(1).WillReturnError(errors.New("error exec"))
+	mock.ExpectRollback()
+
+	err = NewPrivacyRepository(db).PurgeUserData(context.Background(), 1)
+
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,200 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"expvar"
+	"my-project/infrastructure/logger"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// slowQueries counts queries above the threshold per repository method. It
+// is served with the other expvars on /api/admin/debug/vars.
+var slowQueries = expvar.NewMap("sql_slow_queries")
+
+const persistencePackage = "my-project/infrastructure/persistence."
+
+// QueryLogger times every statement sent through a connector wrapped by
+// NewLoggedConnector. Statements slower than threshold are logged as
+// warnings; with logAll the others are logged at debug level.
+type QueryLogger struct {
+	threshold time.Duration
+	logAll    bool
+}
+
+func NewQueryLogger(threshold time.Duration, logAll bool) *QueryLogger {
+	return &QueryLogger{threshold: threshold, logAll: logAll}
+}
+
+func (queryLogger *QueryLogger) observe(query string, started time.Time, err error) {
+	elapsed := time.Since(started)
+	slow := queryLogger.threshold > 0 && elapsed >= queryLogger.threshold
+	if !slow && !queryLogger.logAll {
+		return
+	}
+
+	method := callerMethod()
+	entry := logger.GetLogger().
+		WithField("method", method).
+		WithField("query", strings.Join(strings.Fields(query), " ")).
+		WithField("duration_ms", elapsed.Milliseconds())
+	if err != nil && err != driver.ErrSkip {
+		entry = entry.WithField("error", err)
+	}
+	if slow {
+		slowQueries.Add(method, 1)
+		entry.Warn("Slow query")
+		return
+	}
+	entry.Debug("Query")
+}
+
+// callerMethod names the repository method that issued the query, e.g.
+// "SearchRepository.GetHistoryByUserId".
+func callerMethod() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, persistencePackage); ok && !strings.HasPrefix(name, "(*logged") && !strings.HasPrefix(name, "(*QueryLogger)") {
+			return strings.NewReplacer("(*", "", ")", "").Replace(name)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// NewLoggedConnector wraps a driver connector so every query and exec on its
+// connections is timed by queryLogger.
+func NewLoggedConnector(connector driver.Connector, queryLogger *QueryLogger) driver.Connector {
+	return &loggedConnector{Connector: connector, queryLogger: queryLogger}
+}
+
+type loggedConnector struct {
+	driver.Connector
+	queryLogger *QueryLogger
+}
+
+func (connector *loggedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := connector.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &loggedConn{Conn: conn, queryLogger: connector.queryLogger}, nil
+}
+
+type loggedConn struct {
+	driver.Conn
+	queryLogger *QueryLogger
+}
+
+func (conn *loggedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	var err error
+	if preparer, ok := conn.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = conn.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &loggedStmt{Stmt: stmt, query: query, queryLogger: conn.queryLogger}, nil
+}
+
+func (conn *loggedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := conn.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return conn.Conn.Begin() //nolint:staticcheck // fallback for drivers without BeginTx
+}
+
+func (conn *loggedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := conn.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	started := time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		conn.queryLogger.observe(query, started, err)
+	}
+	return result, err
+}
+
+func (conn *loggedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := conn.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	started := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		conn.queryLogger.observe(query, started, err)
+	}
+	return rows, err
+}
+
+func (conn *loggedConn) Ping(ctx context.Context) error {
+	if pinger, ok := conn.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (conn *loggedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := conn.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (conn *loggedConn) IsValid() bool {
+	if validator, ok := conn.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+type loggedStmt struct {
+	driver.Stmt
+	query       string
+	queryLogger *QueryLogger
+}
+
+func (stmt *loggedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	started := time.Now()
+	var result driver.Result
+	var err error
+	if execer, ok := stmt.Stmt.(driver.StmtExecContext); ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		result, err = stmt.Stmt.Exec(namedValues(args)) //nolint:staticcheck // fallback for drivers without ExecContext
+	}
+	stmt.queryLogger.observe(stmt.query, started, err)
+	return result, err
+}
+
+func (stmt *loggedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	started := time.Now()
+	var rows driver.Rows
+	var err error
+	if queryer, ok := stmt.Stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		rows, err = stmt.Stmt.Query(namedValues(args)) //nolint:staticcheck // fallback for drivers without QueryContext
+	}
+	stmt.queryLogger.observe(stmt.query, started, err)
+	return rows, err
+}
+
+func namedValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return values
+}
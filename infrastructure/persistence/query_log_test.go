@@ -0,0 +1,58 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// sleepConnector hands out connections whose statements take delay to run.
+type sleepConnector struct{ delay time.Duration }
+
+func (connector sleepConnector) Connect(context.Context) (driver.Conn, error) {
+	return sleepConn(connector), nil
+}
+func (connector sleepConnector) Driver() driver.Driver { return nil }
+
+type sleepConn struct{ delay time.Duration }
+
+func (conn sleepConn) Prepare(query string) (driver.Stmt, error) { return sleepStmt(conn), nil }
+func (sleepConn) Close() error                                   { return nil }
+func (sleepConn) Begin() (driver.Tx, error)                      { return nil, driver.ErrSkip }
+
+type sleepStmt struct{ delay time.Duration }
+
+func (sleepStmt) Close() error  { return nil }
+func (sleepStmt) NumInput() int { return -1 }
+func (stmt sleepStmt) Exec(args []driver.Value) (driver.Result, error) {
+	time.Sleep(stmt.delay)
+	return driver.RowsAffected(1), nil
+}
+func (sleepStmt) Query(args []driver.Value) (driver.Rows, error) { return nil, driver.ErrSkip }
+
+func slowQueryCount(method string) int64 {
+	if count, ok := slowQueries.Get(method).(*expvar.Int); ok {
+		return count.Value()
+	}
+	return 0
+}
+
+func TestLoggedConnector_CountsSlowQueriesPerMethod(t *testing.T) {
+	queryLogger := NewQueryLogger(5*time.Millisecond, false)
+	before := slowQueryCount("SearchRepository.DeleteAllHistory")
+
+	slowDB := sql.OpenDB(NewLoggedConnector(sleepConnector{delay: 10 * time.Millisecond}, queryLogger))
+	defer slowDB.Close()
+	require.NoError(t, NewSearchRepository(slowDB).DeleteAllHistory(context.Background(), 1))
+	require.Equal(t, before+1, slowQueryCount("SearchRepository.DeleteAllHistory"))
+
+	fastDB := sql.OpenDB(NewLoggedConnector(sleepConnector{}, queryLogger))
+	defer fastDB.Close()
+	require.NoError(t, NewSearchRepository(fastDB).DeleteAllHistory(context.Background(), 1))
+	require.Equal(t, before+1, slowQueryCount("SearchRepository.DeleteAllHistory"))
+}
@@ -0,0 +1,213 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+type SearchRepository struct {
+	statements *StatementCache
+}
+
+func NewSearchRepository(sqlDB *sql.DB) repository.ISearch {
+	return &SearchRepository{statements: NewStatementCache(sqlDB)}
+}
+
+func (searchRepository *SearchRepository) CreateHistory(ctx context.Context, history model.SearchHistory) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := searchRepository.statements.PrepareContext(ctx, `INSERT INTO public.search_history (user_id, query) VALUES ($1, $2)`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	_, err = statement.ExecContext(ctx, history.UserID, history.Query)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return nil
+}
+
+// GetHistoryByUserId returns the newest entries first. A limit of zero or less
+// returns the whole history.
+func (searchRepository *SearchRepository) GetHistoryByUserId(ctx context.Context, userId int64, limit int) ([]model.SearchHistory, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	histories := []model.SearchHistory{}
+	statement, err := searchRepository.statements.PrepareContext(ctx, `SELECT sh.id, sh.user_id, sh.query, sh.created_at
+	FROM public.search_history AS sh
+	WHERE sh.user_id = $1
+	ORDER BY sh.created_at DESC
+	LIMIT $2`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return histories, err
+	}
+
+	var limitArg interface{} = limit
+	if limit <= 0 {
+		limitArg = nil
+	}
+	rows, err := statement.QueryContext(ctx, userId, limitArg)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return histories, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var history model.SearchHistory
+		err = rows.Scan(&history.ID, &history.UserID, &history.Query, &history.CreatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return histories, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, rows.Err()
+}
+
+func (searchRepository *SearchRepository) DeleteHistory(ctx context.Context, userId int64, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := searchRepository.statements.PrepareContext(ctx, `DELETE FROM public.search_history WHERE id = $1 AND user_id = $2`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, id, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+func (searchRepository *SearchRepository) DeleteAllHistory(ctx context.Context, userId int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := searchRepository.statements.PrepareContext(ctx, `DELETE FROM public.search_history WHERE user_id = $1`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	_, err = statement.ExecContext(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (searchRepository *SearchRepository) DeleteHistoryBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := searchRepository.statements.PrepareContext(ctx, `DELETE FROM public.search_history WHERE created_at < $1`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return 0, err
+	}
+
+	result, err := statement.ExecContext(ctx, before)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (searchRepository *SearchRepository) CreateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	statement, err := searchRepository.statements.PrepareContext(ctx, `INSERT INTO public.saved_search (user_id, name, query) VALUES ($1, $2, $3)
+	ON CONFLICT (user_id, name) DO NOTHING RETURNING id`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return id, err
+	}
+
+	err = statement.QueryRowContext(ctx, savedSearch.UserID, savedSearch.Name, savedSearch.Query).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return id, err
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	return id, nil
+}
+
+func (searchRepository *SearchRepository) GetSavedSearchesByUserId(ctx context.Context, userId int64) ([]model.SavedSearch, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	savedSearches := []model.SavedSearch{}
+	statement, err := searchRepository.statements.PrepareContext(ctx, `SELECT ss.id, ss.user_id, ss.name, ss.query, ss.created_at, ss.updated_at
+	FROM public.saved_search AS ss
+	WHERE ss.user_id = $1
+	ORDER BY ss.name`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return savedSearches, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return savedSearches, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var savedSearch model.SavedSearch
+		err = rows.Scan(&savedSearch.ID, &savedSearch.UserID, &savedSearch.Name, &savedSearch.Query, &savedSearch.CreatedAt, &savedSearch.UpdatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return savedSearches, err
+		}
+		savedSearches = append(savedSearches, savedSearch)
+	}
+
+	return savedSearches, rows.Err()
+}
+
+func (searchRepository *SearchRepository) DeleteSavedSearch(ctx context.Context, userId int64, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := searchRepository.statements.PrepareContext(ctx, `DELETE FROM public.saved_search WHERE id = $1 AND user_id = $2`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, id, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
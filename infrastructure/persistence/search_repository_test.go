@@ -0,0 +1,83 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRepository_GetHistoryByUserId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Now()
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`SELECT sh.id, sh.user_id, sh.query, sh.created_at
+	FROM public.search_history AS sh`))
+	prep.ExpectQuery().WithArgs(1, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "query", "created_at"}).
+			AddRow(1, 1, "golang", createdAt).
+			AddRow(2, 1, "gin", createdAt))
+
+	res, err := NewSearchRepository(db).GetHistoryByUserId(context.Background(), 1, 50)
+
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "gin", res[1].Query)
+}
+
+func TestSearchRepository_DeleteHistoryNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`DELETE FROM public.search_history WHERE id = $1 AND user_id = $2`))
+	prep.ExpectExec().WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewSearchRepository(db).DeleteHistory(context.Background(), 1, 2)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSearchRepository_CreateSavedSearchDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`ON CONFLICT (user_id, name) DO NOTHING RETURNING id`))
+	prep.ExpectQuery().WithArgs(1, "Tutorials", "golang tutorial").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err = NewSearchRepository(db).CreateSavedSearch(context.Background(), model.SavedSearch{
+		UserID: 1,
+		Name:   "Tutorials",
+		Query:  "golang tutorial",
+	})
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSearchRepository_CreateSavedSearch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.saved_search (user_id, name, query) VALUES ($1, $2, $3)
+	ON CONFLICT (user_id, name) DO NOTHING RETURNING id`))
+	prep.ExpectQuery().WithArgs(1, "Tutorials", "golang tutorial").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+	id, err := NewSearchRepository(db).CreateSavedSearch(context.Background(), model.SavedSearch{
+		UserID: 1,
+		Name:   "Tutorials",
+		Query:  "golang tutorial",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(7), id)
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type SeedRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewSeedRepository(sqlDB *sql.DB) repository.ISeed {
+	return &SeedRepository{sqlDB: sqlDB}
+}
+
+// CreateUserWithData inserts the user and its saved searches, bookmarks and
+// notes together, so a failed seed leaves nothing behind for the next run to
+// skip.
+func (seedRepository *SeedRepository) CreateUserWithData(ctx context.Context, user model.User, savedSearches []model.SavedSearch, bookmarks []model.VideoBookmark, notes []model.VideoNote) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	tx, err := seedRepository.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while begin transaction")
+		return id, err
+	}
+	defer tx.Rollback()
+
+	status := user.Status
+	if status == "" {
+		status = model.UserStatusActive
+	}
+	err = tx.QueryRowContext(ctx, `INSERT INTO public.user (name, user_name, password, email, status) VALUES ($1, $2, $3, NULLIF($4, ''), $5) RETURNING id`,
+		user.Name, user.UserName, user.Password, user.Email, status).Scan(&id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	for _, savedSearch := range savedSearches {
+		_, err = tx.ExecContext(ctx, `INSERT INTO public.saved_search (user_id, name, query) VALUES ($1, $2, $3)`, id, savedSearch.Name, savedSearch.Query)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error execute query")
+			return id, err
+		}
+	}
+	for _, bookmark := range bookmarks {
+		_, err = tx.ExecContext(ctx, `INSERT INTO public.user_video_bookmarks (user_id, video_id) VALUES ($1, $2)
+		ON CONFLICT (user_id, video_id) DO NOTHING`, id, bookmark.VideoID)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error execute query")
+			return id, err
+		}
+	}
+	for _, note := range notes {
+		_, err = tx.ExecContext(ctx, `INSERT INTO public.video_notes (video_id, user_id, body) VALUES ($1, $2, $3)`, note.VideoID, id, note.Body)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error execute query")
+			return id, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while commit transaction")
+		return id, err
+	}
+
+	return id, nil
+}
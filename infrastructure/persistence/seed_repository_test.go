@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeedRepository_CreateUserWithData(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password, email, status)`)).
+		WithArgs("Demo Editor", "demo", "hash", "", model.UserStatusActive).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.saved_search (user_id, name, query)`)).
+		WithArgs(4, "Go tutorials", "golang tutorial").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.user_video_bookmarks (user_id, video_id)`)).
+		WithArgs(4, "dQw4w9WgXcQ").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.video_notes (video_id, user_id, body)`)).
+		WithArgs("dQw4w9WgXcQ", 4, "Add chapters.").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	id, err := NewSeedRepository(db).CreateUserWithData(context.Background(),
+		model.User{Name: "Demo Editor", UserName: "demo", Password: "hash"},
+		[]model.SavedSearch{{Name: "Go tutorials", Query: "golang tutorial"}},
+		[]model.VideoBookmark{{VideoID: "dQw4w9WgXcQ"}},
+		[]model.VideoNote{{VideoID: "dQw4w9WgXcQ", Body: "Add chapters."}})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(4), id)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSeedRepository_CreateUserWithDataRollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password, email, status)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.video_notes (video_id, user_id, body)`)).
+		WillReturnError(errors.New("value too long"))
+	mock.ExpectRollback()
+
+	_, err = NewSeedRepository(db).CreateUserWithData(context.Background(), model.User{UserName: "demo"}, nil, nil,
+		[]model.VideoNote{{VideoID: "dQw4w9WgXcQ"}})
+
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+type SessionRepository struct {
+	statements *StatementCache
+}
+
+func NewSessionRepository(sqlDB *sql.DB) repository.ISession {
+	return &SessionRepository{statements: NewStatementCache(sqlDB)}
+}
+
+func (sessionRepository *SessionRepository) CreateSession(ctx context.Context, session model.UserSession) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	statement, err := sessionRepository.statements.PrepareContext(ctx, `INSERT INTO public.user_sessions (user_id, user_agent, ip_address) VALUES ($1, $2, $3) RETURNING id`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return id, err
+	}
+
+	err = statement.QueryRowContext(ctx, session.UserID, session.UserAgent, session.IPAddress).Scan(&id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return id, err
+	}
+
+	return id, nil
+}
+
+// GetSessionsByUserId returns the sessions that have not been revoked and
+// whose token is still valid, most recently used first. Sessions have no
+// refresh token, so one ends when the token issued at login expires.
+func (sessionRepository *SessionRepository) GetSessionsByUserId(ctx context.Context, userId int64) ([]model.UserSession, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	sessions := []model.UserSession{}
+	statement, err := sessionRepository.statements.PrepareContext(ctx, `SELECT s.id, s.user_id, s.user_agent, s.ip_address, s.created_at, s.last_seen_at
+	FROM public.user_sessions AS s
+	WHERE s.user_id = $1 AND s.revoked_at IS NULL AND s.created_at > $2
+	ORDER BY s.last_seen_at DESC`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return sessions, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId, time.Now().Add(-model.SessionTokenLifetime))
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return sessions, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var session model.UserSession
+		err = rows.Scan(&session.ID, &session.UserID, &session.UserAgent, &session.IPAddress, &session.CreatedAt, &session.LastSeenAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return sessions, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+// IsSessionRevoked reports whether tokens of the session must be rejected. A
+// session that does not exist counts as revoked.
+func (sessionRepository *SessionRepository) IsSessionRevoked(ctx context.Context, id int64) (bool, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := sessionRepository.statements.PrepareContext(ctx, `SELECT s.revoked_at IS NOT NULL FROM public.user_sessions AS s WHERE s.id = $1`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return true, err
+	}
+
+	var revoked bool
+	err = statement.QueryRowContext(ctx, id).Scan(&revoked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return true, nil
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return true, err
+	}
+
+	return revoked, nil
+}
+
+// TouchSession records activity on a session. Like TouchToken, writes are
+// skipped while the stored timestamp is less than a minute old.
+func (sessionRepository *SessionRepository) TouchSession(ctx context.Context, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := sessionRepository.statements.PrepareContext(ctx, `UPDATE public.user_sessions SET last_seen_at = NOW()
+	WHERE id = $1 AND revoked_at IS NULL AND last_seen_at < NOW() - INTERVAL '1 minute'`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	_, err = statement.ExecContext(ctx, id)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return nil
+}
+
+func (sessionRepository *SessionRepository) RevokeSession(ctx context.Context, userId int64, id int64) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := sessionRepository.statements.PrepareContext(ctx, `UPDATE public.user_sessions SET revoked_at = NOW()
+	WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, id, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
+
+// DeleteSessionsBefore removes sessions created before the given time,
+// revoked or not.
+func (sessionRepository *SessionRepository) DeleteSessionsBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := sessionRepository.statements.PrepareContext(ctx, `DELETE FROM public.user_sessions WHERE created_at < $1`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return 0, err
+	}
+
+	result, err := statement.ExecContext(ctx, before)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRepository_CreateSession(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user_sessions (user_id, user_agent, ip_address) VALUES ($1, $2, $3) RETURNING id`))
+	prep.ExpectQuery().WithArgs(1, "Firefox", "10.0.0.1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+	id, err := NewSessionRepository(db).CreateSession(context.Background(), model.UserSession{
+		UserID:    1,
+		UserAgent: "Firefox",
+		IPAddress: "10.0.0.1",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(5), id)
+}
+
+func TestSessionRepository_IsSessionRevoked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`SELECT s.revoked_at IS NOT NULL FROM public.user_sessions AS s WHERE s.id = $1`))
+	prep.ExpectQuery().WithArgs(5).WillReturnRows(sqlmock.NewRows([]string{"revoked"}).AddRow(false))
+	prep.ExpectQuery().WithArgs(6).WillReturnRows(sqlmock.NewRows([]string{"revoked"}))
+
+	repository := NewSessionRepository(db)
+	revoked, err := repository.IsSessionRevoked(context.Background(), 5)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	revoked, err = repository.IsSessionRevoked(context.Background(), 6)
+	require.NoError(t, err)
+	require.True(t, revoked, "a missing session counts as revoked")
+}
+
+func TestSessionRepository_RevokeSessionNotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`UPDATE public.user_sessions SET revoked_at = NOW()`))
+	prep.ExpectExec().WithArgs(5, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = NewSessionRepository(db).RevokeSession(context.Background(), 1, 5)
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSessionRepository_GetSessionsByUserIdSkipsExpired(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`WHERE s.user_id = $1 AND s.revoked_at IS NULL AND s.created_at > $2`))
+	prep.ExpectQuery().WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "user_agent", "ip_address", "created_at", "last_seen_at"}))
+
+	sessions, err := NewSessionRepository(db).GetSessionsByUserId(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestSessionRepository_DeleteSessionsBefore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	before := time.Now().Add(-model.SessionTokenLifetime)
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`DELETE FROM public.user_sessions WHERE created_at < $1`))
+	prep.ExpectExec().WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 4))
+
+	deleted, err := NewSessionRepository(db).DeleteSessionsBefore(context.Background(), before)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(4), deleted)
+}
@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// StatementCache keeps one prepared statement per query for the lifetime of
+// the repository. database/sql re-prepares a cached statement transparently
+// on connections that have not seen it yet, so callers must not close the
+// statements they get back.
+type StatementCache struct {
+	sqlDB      *sql.DB
+	mu         sync.RWMutex
+	statements map[string]*sql.Stmt
+}
+
+func NewStatementCache(sqlDB *sql.DB) *StatementCache {
+	return &StatementCache{sqlDB: sqlDB, statements: make(map[string]*sql.Stmt)}
+}
+
+func (cache *StatementCache) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	cache.mu.RLock()
+	statement, ok := cache.statements[query]
+	cache.mu.RUnlock()
+	if ok {
+		return statement, nil
+	}
+
+	statement, err := cache.sqlDB.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if existing, ok := cache.statements[query]; ok {
+		// Another caller prepared the same query first; keep theirs.
+		statement.Close()
+		return existing, nil
+	}
+	cache.statements[query] = statement
+	return statement, nil
+}
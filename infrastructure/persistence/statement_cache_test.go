@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementCache_PreparesOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	prep := mock.ExpectPrepare(regexp.QuoteMeta(`SELECT 1`))
+	prep.ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"one"}).AddRow(1))
+	prep.ExpectQuery().WillReturnRows(sqlmock.NewRows([]string{"one"}).AddRow(1))
+
+	cache := NewStatementCache(db)
+	for i := 0; i < 2; i++ {
+		statement, err := cache.PrepareContext(context.Background(), `SELECT 1`)
+		require.NoError(t, err)
+		var one int
+		require.NoError(t, statement.QueryRow().Scan(&one))
+	}
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// roundTrip stands in for the network latency of one database call.
+const roundTrip = 50 * time.Microsecond
+
+type latencyDriver struct{}
+
+func (latencyDriver) Open(name string) (driver.Conn, error) { return latencyConn{}, nil }
+
+type latencyConn struct{}
+
+func (latencyConn) Prepare(query string) (driver.Stmt, error) {
+	time.Sleep(roundTrip)
+	return latencyStmt{}, nil
+}
+func (latencyConn) Close() error              { return nil }
+func (latencyConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type latencyStmt struct{}
+
+func (latencyStmt) Close() error  { return nil }
+func (latencyStmt) NumInput() int { return 1 }
+func (latencyStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, driver.ErrSkip
+}
+func (latencyStmt) Query(args []driver.Value) (driver.Rows, error) {
+	time.Sleep(roundTrip)
+	return &latencyRows{}, nil
+}
+
+type latencyRows struct{ done bool }
+
+func (*latencyRows) Columns() []string {
+	return []string{"id", "name", "user_name", "password", "status", "created_at", "updated_at"}
+}
+func (*latencyRows) Close() error { return nil }
+func (rows *latencyRows) Next(dest []driver.Value) error {
+	if rows.done {
+		return io.EOF
+	}
+	rows.done = true
+	now := time.Now()
+	copy(dest, []driver.Value{int64(1), "Lambok Tulus Simamora", "lamboktulus1379", "a252f77af72638ea5a0f9e5fbe5f2b2e", "active", now, now})
+	return nil
+}
+
+func init() {
+	sql.Register("latency", latencyDriver{})
+}
+
+// BenchmarkUserRepository_GetByUserName compares preparing the statement on
+// every call, as the repository used to, with the cached statement.
+func BenchmarkUserRepository_GetByUserName(b *testing.B) {
+	db, err := sql.Open("latency", "")
+	require.NoError(b, err)
+	defer db.Close()
+	db.SetMaxOpenConns(16)
+	db.SetMaxIdleConns(16)
+
+	query := `SELECT u.id, u.name, u.user_name, u.password, u.status, u.created_at, u.updated_at 
+	FROM public.user AS u 
+	WHERE u.user_name = $1`
+
+	b.Run("prepare_per_call", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				statement, err := db.PrepareContext(context.Background(), query)
+				if err != nil {
+					b.Fatal(err)
+				}
+				var id int64
+				var name, userName, password, status string
+				var createdAt, updatedAt time.Time
+				err = statement.QueryRow("lamboktulus1379").Scan(&id, &name, &userName, &password, &status, &createdAt, &updatedAt)
+				statement.Close()
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		userRepository := NewUserRepository(db)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := userRepository.GetByUserName(context.Background(), "lamboktulus1379"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
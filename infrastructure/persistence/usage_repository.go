@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+type UsageRepository struct {
+	sqlDB      *sql.DB
+	statements *StatementCache
+}
+
+func NewUsageRepository(sqlDB *sql.DB) repository.IUsage {
+	return &UsageRepository{sqlDB: sqlDB, statements: NewStatementCache(sqlDB)}
+}
+
+// AddUsage adds the counts to the stored totals in one transaction, so a
+// flush is either stored completely or not at all.
+func (usageRepository *UsageRepository) AddUsage(ctx context.Context, usages []model.APIUsage) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := usageRepository.statements.PrepareContext(ctx, `INSERT INTO public.api_usage (user_id, day, endpoint, count) VALUES ($1, $2, $3, $4)
+	ON CONFLICT (user_id, day, endpoint) DO UPDATE SET count = public.api_usage.count + EXCLUDED.count`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	tx, err := usageRepository.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while begin transaction")
+		return err
+	}
+	defer tx.Rollback()
+
+	txStatement := tx.StmtContext(ctx, statement)
+	for _, usage := range usages {
+		_, err = txStatement.ExecContext(ctx, usage.UserID, usage.Day, usage.Endpoint, usage.Count)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error execute query")
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while commit transaction")
+		return err
+	}
+
+	return nil
+}
+
+// GetUsageByUserId returns the user's counts per day and endpoint from since
+// on, oldest day first.
+func (usageRepository *UsageRepository) GetUsageByUserId(ctx context.Context, userId int64, since time.Time) ([]model.APIUsage, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	usages := []model.APIUsage{}
+	statement, err := usageRepository.statements.PrepareContext(ctx, `SELECT u.user_id, u.day, u.endpoint, u.count
+	FROM public.api_usage AS u
+	WHERE u.user_id = $1 AND u.day >= $2
+	ORDER BY u.day, u.endpoint`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return usages, err
+	}
+
+	rows, err := statement.QueryContext(ctx, userId, since)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return usages, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var usage model.APIUsage
+		err = rows.Scan(&usage.UserID, &usage.Day, &usage.Endpoint, &usage.Count)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return usages, err
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, rows.Err()
+}
+
+// GetUsageTotals sums the counts from since on per user and endpoint. Day is
+// left zero in the results.
+func (usageRepository *UsageRepository) GetUsageTotals(ctx context.Context, since time.Time) ([]model.APIUsage, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	usages := []model.APIUsage{}
+	statement, err := usageRepository.statements.PrepareContext(ctx, `SELECT u.user_id, u.endpoint, SUM(u.count)
+	FROM public.api_usage AS u
+	WHERE u.day >= $1
+	GROUP BY u.user_id, u.endpoint
+	ORDER BY u.user_id, u.endpoint`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return usages, err
+	}
+
+	rows, err := statement.QueryContext(ctx, since)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return usages, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var usage model.APIUsage
+		err = rows.Scan(&usage.UserID, &usage.Endpoint, &usage.Count)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return usages, err
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, rows.Err()
+}
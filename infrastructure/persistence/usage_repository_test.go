@@ -0,0 +1,55 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"my-project/domain/model"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+const upsertUsageQuery = `INSERT INTO public.api_usage (user_id, day, endpoint, count) VALUES ($1, $2, $3, $4)`
+
+func TestUsageRepository_AddUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectPrepare(regexp.QuoteMeta(upsertUsageQuery))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(upsertUsageQuery)).WithArgs(1, day, "GET /api/bookmarks", 3).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(upsertUsageQuery)).WithArgs(2, day, "GET /api/me/sessions", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = NewUsageRepository(db).AddUsage(context.Background(), []model.APIUsage{
+		{UserID: 1, Day: day, Endpoint: "GET /api/bookmarks", Count: 3},
+		{UserID: 2, Day: day, Endpoint: "GET /api/me/sessions", Count: 1},
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUsageRepository_AddUsageRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectPrepare(regexp.QuoteMeta(upsertUsageQuery))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(upsertUsageQuery)).WithArgs(1, day, "GET /api/bookmarks", 3).WillReturnError(errors.New("error exec"))
+	mock.ExpectRollback()
+
+	err = NewUsageRepository(db).AddUsage(context.Background(), []model.APIUsage{
+		{UserID: 1, Day: day, Endpoint: "GET /api/bookmarks", Count: 3},
+	})
+
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -10,17 +10,20 @@ import (
 )
 
 type UserRepository struct {
-	sqlDB *sql.DB
+	statements *StatementCache
 }
 
 func NewUserRepository(sqlDB *sql.DB) repository.IUser {
-	return &UserRepository{sqlDB}
+	return &UserRepository{statements: NewStatementCache(sqlDB)}
 }
 
 func (userRepository *UserRepository) GetById(ctx context.Context, id int) (model.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	var user model.User
 
-	statement, err := userRepository.sqlDB.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	statement, err := userRepository.statements.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), COALESCE(u.email, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.id = $1`)
 
@@ -28,10 +31,9 @@ func (userRepository *UserRepository) GetById(ctx context.Context, id int) (mode
 		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
 		return user, err
 	}
-	defer statement.Close()
 
-	result := statement.QueryRow(id)
-	err = result.Scan(&user.ID, &user.Name, &user.UserName, &user.Password, &user.CreatedAt, &user.UpdatedAt)
+	result := statement.QueryRowContext(ctx, id)
+	err = result.Scan(&user.ID, &user.Name, &user.UserName, &user.Password, &user.Email, &user.Status, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while query")
 		return user, err
@@ -41,9 +43,12 @@ func (userRepository *UserRepository) GetById(ctx context.Context, id int) (mode
 }
 
 func (userRepository *UserRepository) GetByUserName(ctx context.Context, userName string) (model.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
 	var user model.User
 
-	statement, err := userRepository.sqlDB.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	statement, err := userRepository.statements.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.user_name = $1`)
 
@@ -51,10 +56,34 @@ func (userRepository *UserRepository) GetByUserName(ctx context.Context, userNam
 		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
 		return user, err
 	}
-	defer statement.Close()
 
-	result := statement.QueryRow(userName)
-	err = result.Scan(&user.ID, &user.Name, &user.UserName, &user.Password, &user.CreatedAt, &user.UpdatedAt)
+	result := statement.QueryRowContext(ctx, userName)
+	err = result.Scan(&user.ID, &user.Name, &user.UserName, &user.Password, &user.Status, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return user, err
+	}
+
+	return user, nil
+}
+
+func (userRepository *UserRepository) GetByEmail(ctx context.Context, email string) (model.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	var user model.User
+
+	statement, err := userRepository.statements.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), u.email, u.status, u.created_at, u.updated_at
+	FROM public.user AS u
+	WHERE LOWER(u.email) = LOWER($1)`)
+
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return user, err
+	}
+
+	result := statement.QueryRowContext(ctx, email)
+	err = result.Scan(&user.ID, &user.Name, &user.UserName, &user.Password, &user.Email, &user.Status, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while query")
 		return user, err
@@ -63,16 +92,23 @@ func (userRepository *UserRepository) GetByUserName(ctx context.Context, userNam
 	return user, nil
 }
 
+// CreateUser stores the user as active unless another status is given.
 func (userRepository *UserRepository) CreateUser(ctx context.Context, user model.User) error {
-	statement, err := userRepository.sqlDB.PrepareContext(ctx, `INSERT INTO public.user (name, user_name, password) VALUES ($1, $2, $3)`)
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := userRepository.statements.PrepareContext(ctx, `INSERT INTO public.user (name, user_name, password, email, status) VALUES ($1, $2, $3, NULLIF($4, ''), $5)`)
 
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
 		return err
 	}
-	defer statement.Close()
 
-	_, err = statement.Exec(user.Name, user.UserName, user.Password)
+	status := user.Status
+	if status == "" {
+		status = model.UserStatusActive
+	}
+	_, err = statement.ExecContext(ctx, user.Name, user.UserName, user.Password, user.Email, status)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error execute query")
 		return err
@@ -80,3 +116,59 @@ func (userRepository *UserRepository) CreateUser(ctx context.Context, user model
 
 	return nil
 }
+
+func (userRepository *UserRepository) GetByStatus(ctx context.Context, status string) ([]model.User, error) {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	users := []model.User{}
+
+	statement, err := userRepository.statements.PrepareContext(ctx, `SELECT u.id, u.name, u.user_name, COALESCE(u.email, ''), u.status, u.created_at, u.updated_at
+	FROM public.user AS u
+	WHERE u.status = $1
+	ORDER BY u.created_at`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return users, err
+	}
+
+	rows, err := statement.QueryContext(ctx, status)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while query")
+		return users, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user model.User
+		err = rows.Scan(&user.ID, &user.Name, &user.UserName, &user.Email, &user.Status, &user.CreatedAt, &user.UpdatedAt)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while scan")
+			return users, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// UpdateStatus moves a user from one status to another. It returns
+// sql.ErrNoRows when the user does not exist or is not in the from status.
+func (userRepository *UserRepository) UpdateStatus(ctx context.Context, id int64, from string, to string) error {
+	ctx, cancel := withQueryTimeout(ctx)
+	defer cancel()
+
+	statement, err := userRepository.statements.PrepareContext(ctx, `UPDATE public.user SET status = $1, updated_at = NOW() WHERE id = $2 AND status = $3`)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while prepare statement")
+		return err
+	}
+
+	result, err := statement.ExecContext(ctx, to, id, from)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error execute query")
+		return err
+	}
+
+	return requireAffected(result)
+}
@@ -22,7 +22,7 @@ type Suite struct {
 	repository repository.IUser
 }
 
-func (s *Suite) SetupSuite() {
+func (s *Suite) SetupTest() {
 	var (
 		db  *sql.DB
 		err error
@@ -50,12 +50,12 @@ func (s *Suite) TestUserRepository_GetById() {
 		UpdatedAt = updatedAtTime.In(loc)
 	)
 
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), COALESCE(u.email, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.id = $1`))
 	prep.ExpectQuery().WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_name", "password", "created_at", "updated_at"}).
-			AddRow(ID, Name, UserName, Password, CreatedAt, UpdatedAt))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_name", "password", "email", "status", "created_at", "updated_at"}).
+			AddRow(ID, Name, UserName, Password, "tulus@example.com", "active", CreatedAt, UpdatedAt))
 
 	res, err := s.repository.GetById(context.Background(), 1)
 	exp := model.User{
@@ -63,6 +63,8 @@ func (s *Suite) TestUserRepository_GetById() {
 		Name:      "Lambok Tulus Simamora",
 		UserName:  "lamboktulus1379",
 		Password:  "a252f77af72638ea5a0f9e5fbe5f2b2e",
+		Email:     "tulus@example.com",
+		Status:    "active",
 		CreatedAt: CreatedAt,
 		UpdatedAt: UpdatedAt,
 	}
@@ -72,7 +74,7 @@ func (s *Suite) TestUserRepository_GetById() {
 }
 
 func (s *Suite) TestUserRepository_GetByIdErrPrepare() {
-	s.mock.ExpectPrepare(`SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	s.mock.ExpectPrepare(`SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), COALESCE(u.email, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.id = $1`).
 		WillReturnError(fmt.Errorf("error statement"))
@@ -97,12 +99,12 @@ func (s *Suite) TestUserRepository_GetByIdErrScan() {
 		UpdatedAt = updatedAtTime.In(loc)
 	)
 
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), COALESCE(u.email, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.id = $1`))
 	prep.ExpectQuery().WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_name", "password", "created_at", "updated_at"}).
-			AddRow(ID, Name, UserName, Password, CreatedAt, UpdatedAt)).WillReturnError(fmt.Errorf("error scan"))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_name", "password", "email", "status", "created_at", "updated_at"}).
+			AddRow(ID, Name, UserName, Password, "", "active", CreatedAt, UpdatedAt)).WillReturnError(fmt.Errorf("error scan"))
 
 	_, err := s.repository.GetById(context.Background(), 1)
 	// exp := errors.New("sql: expected 5 destination arguments in Scan, not 6")
@@ -124,12 +126,12 @@ func (s *Suite) TestUserRepository_GetByUserName() {
 		UpdatedAt = updatedAtTime.In(loc)
 	)
 
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.user_name = $1`))
 	prep.ExpectQuery().WithArgs("lamboktulus1379").
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_name", "password", "created_at", "updated_at"}).
-			AddRow(ID, Name, UserName, Password, CreatedAt, UpdatedAt))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "user_name", "password", "status", "created_at", "updated_at"}).
+			AddRow(ID, Name, UserName, Password, "active", CreatedAt, UpdatedAt))
 
 	res, err := s.repository.GetByUserName(context.Background(), "lamboktulus1379")
 	exp := model.User{
@@ -137,6 +139,7 @@ func (s *Suite) TestUserRepository_GetByUserName() {
 		Name:      "Lambok Tulus Simamora",
 		UserName:  "lamboktulus1379",
 		Password:  "a252f77af72638ea5a0f9e5fbe5f2b2e",
+		Status:    "active",
 		CreatedAt: CreatedAt,
 		UpdatedAt: UpdatedAt,
 	}
@@ -146,7 +149,7 @@ func (s *Suite) TestUserRepository_GetByUserName() {
 }
 
 func (s *Suite) TestUserRepository_GetByUserNameErrPrepare() {
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, u.password, u.created_at, u.updated_at 
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`SELECT u.id, u.name, u.user_name, COALESCE(u.password, ''), u.status, u.created_at, u.updated_at 
 	FROM public.user AS u 
 	WHERE u.user_name = $1`)).WillReturnError(fmt.Errorf("error statement"))
 	prep.ExpectQuery().WithArgs("lamboktulus1379").WillReturnError(errors.New("error expect query"))
@@ -165,8 +168,8 @@ func (s *Suite) TestUserRepository_CreateUser() {
 		Password = "a252f77af72638ea5a0f9e5fbe5f2b2e"
 	)
 
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password) VALUES ($1, $2, $3)`))
-	prep.ExpectExec().WithArgs(Name, UserName, Password).
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password, email, status) VALUES ($1, $2, $3, NULLIF($4, ''), $5)`))
+	prep.ExpectExec().WithArgs(Name, UserName, Password, "", "active").
 		WillReturnResult(sqlmock.NewResult(1, 1)).WillReturnError(nil)
 
 	user := model.User{
@@ -186,8 +189,8 @@ func (s *Suite) TestUserRepository_CreateUserErrPrepare() {
 		Password = "a252f77af72638ea5a0f9e5fbe5f2b2e"
 	)
 
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password) VALUES ($1, $2, $3)`)).WillReturnError(fmt.Errorf("error statement"))
-	prep.ExpectExec().WithArgs(Name, UserName, Password).
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password, email, status) VALUES ($1, $2, $3, NULLIF($4, ''), $5)`)).WillReturnError(fmt.Errorf("error statement"))
+	prep.ExpectExec().WithArgs(Name, UserName, Password, "", "active").
 		WillReturnResult(sqlmock.NewResult(1, 1)).WillReturnError(nil)
 
 	user := model.User{
@@ -207,8 +210,8 @@ func (s *Suite) TestUserRepository_CreateUserErrExec() {
 		Password = "a252f77af72638ea5a0f9e5fbe5f2b2e"
 	)
 
-	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password) VALUES ($1, $2, $3)`)).WillReturnError(fmt.Errorf("error statement"))
-	prep.ExpectExec().WithArgs(Name, UserName, Password).WillReturnError(fmt.Errorf("error exec"))
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`INSERT INTO public.user (name, user_name, password, email, status) VALUES ($1, $2, $3, NULLIF($4, ''), $5)`)).WillReturnError(fmt.Errorf("error statement"))
+	prep.ExpectExec().WithArgs(Name, UserName, Password, "", "active").WillReturnError(fmt.Errorf("error exec"))
 
 	user := model.User{
 		Name:     "Lambok Tulus Simamora",
@@ -219,3 +222,11 @@ func (s *Suite) TestUserRepository_CreateUserErrExec() {
 	err := s.repository.CreateUser(context.Background(), user)
 	require.NotNil(s.T(), err)
 }
+
+func (s *Suite) TestUserRepository_UpdateStatusNotPending() {
+	prep := s.mock.ExpectPrepare(regexp.QuoteMeta(`UPDATE public.user SET status = $1, updated_at = NOW() WHERE id = $2 AND status = $3`))
+	prep.ExpectExec().WithArgs("active", 1, "pending").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := s.repository.UpdateStatus(context.Background(), 1, "pending", "active")
+	require.ErrorIs(s.T(), err, sql.ErrNoRows)
+}
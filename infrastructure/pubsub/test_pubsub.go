@@ -3,6 +3,7 @@ package pubsub
 import (
 	"context"
 	"log"
+	"my-project/infrastructure/feature"
 	"my-project/infrastructure/logger"
 
 	"cloud.google.com/go/pubsub"
@@ -24,6 +25,10 @@ func NewTestPubSub(pubSubClient *pubsub.Client) ITestPubSub {
 }
 
 func (testPubSub *TestPubSub) Publish(ctx context.Context, topicName string, payload []byte) (string, error) {
+	if testPubSub.PubSubClient == nil {
+		return "", feature.ErrDisabled
+	}
+
 	msg := &pubsub.Message{
 		Data: payload,
 	}
@@ -53,6 +58,9 @@ func (testPubSub *TestPubSub) Publish(ctx context.Context, topicName string, pay
 }
 
 func (testPubSub *TestPubSub) GetSubscription(ctx context.Context, subID string) (*pubsub.Subscription, error) {
+	if testPubSub.PubSubClient == nil {
+		return nil, feature.ErrDisabled
+	}
 	logger.GetLogger().WithField("subID", subID).Info("PubSub starting...")
 
 	return testPubSub.PubSubClient.Subscription(subID), nil
@@ -3,6 +3,7 @@ package servicebus
 import (
 	"context"
 	"fmt"
+	"my-project/infrastructure/feature"
 	"my-project/infrastructure/logger"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
@@ -22,6 +23,9 @@ func NewTestServiceBus(azServiceBusClient *azservicebus.Client) ITestServiceBus
 }
 
 func (testServiceBus *TestServicebus) SendMessage(message []byte) error {
+	if testServiceBus.AzservicebusClient == nil {
+		return feature.ErrDisabled
+	}
 	sender, err := testServiceBus.AzservicebusClient.NewSender("test-queue", nil)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while making new sender service bus.")
@@ -42,6 +46,10 @@ func (testServiceBus *TestServicebus) SendMessage(message []byte) error {
 }
 
 func (testServiceBus *TestServicebus) GetMessage(count int) {
+	if testServiceBus.AzservicebusClient == nil {
+		logger.GetLogger().WithField("error", feature.ErrDisabled).Error("Service bus is not configured")
+		return
+	}
 	receiver, err := testServiceBus.AzservicebusClient.NewReceiverForQueue("testqueue", nil)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"my-project/domain/model"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IAccessTokenHandler interface {
+	GetTokens(c *gin.Context)
+	CreateToken(c *gin.Context)
+	RevokeToken(c *gin.Context)
+}
+
+type AccessTokenHandler struct {
+	accessTokenUsecase usecase.IAccessTokenUsecase
+}
+
+func NewAccessTokenHandler(accessTokenUsecase usecase.IAccessTokenUsecase) IAccessTokenHandler {
+	return &AccessTokenHandler{accessTokenUsecase: accessTokenUsecase}
+}
+
+func (accessTokenHandler *AccessTokenHandler) GetTokens(c *gin.Context) {
+	res := accessTokenHandler.accessTokenUsecase.GetTokens(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (accessTokenHandler *AccessTokenHandler) CreateToken(c *gin.Context) {
+	var req model.ReqAccessToken
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := accessTokenHandler.accessTokenUsecase.CreateToken(c.Request.Context(), getUserId(c), req)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (accessTokenHandler *AccessTokenHandler) RevokeToken(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := accessTokenHandler.accessTokenUsecase.RevokeToken(c.Request.Context(), getUserId(c), id)
+
+	c.JSON(http.StatusOK, res)
+}
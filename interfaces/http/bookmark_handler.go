@@ -0,0 +1,40 @@
+package http
+
+import (
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IBookmarkHandler interface {
+	Bookmark(c *gin.Context)
+	Unbookmark(c *gin.Context)
+	GetBookmarks(c *gin.Context)
+}
+
+type BookmarkHandler struct {
+	bookmarkUsecase usecase.IBookmarkUsecase
+}
+
+func NewBookmarkHandler(bookmarkUsecase usecase.IBookmarkUsecase) IBookmarkHandler {
+	return &BookmarkHandler{bookmarkUsecase: bookmarkUsecase}
+}
+
+func (bookmarkHandler *BookmarkHandler) Bookmark(c *gin.Context) {
+	res := bookmarkHandler.bookmarkUsecase.Bookmark(c.Request.Context(), getUserId(c), c.Param("videoId"))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (bookmarkHandler *BookmarkHandler) Unbookmark(c *gin.Context) {
+	res := bookmarkHandler.bookmarkUsecase.Unbookmark(c.Request.Context(), getUserId(c), c.Param("videoId"))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (bookmarkHandler *BookmarkHandler) GetBookmarks(c *gin.Context) {
+	res := bookmarkHandler.bookmarkUsecase.GetBookmarks(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ICapabilityHandler interface {
+	GetCapabilities(c *gin.Context)
+}
+
+type CapabilityHandler struct {
+	capabilityUsecase usecase.ICapabilityUsecase
+}
+
+func NewCapabilityHandler(capabilityUsecase usecase.ICapabilityUsecase) ICapabilityHandler {
+	return &CapabilityHandler{capabilityUsecase: capabilityUsecase}
+}
+
+func (capabilityHandler *CapabilityHandler) GetCapabilities(c *gin.Context) {
+	res := capabilityHandler.capabilityUsecase.GetCapabilities(c.Request.Context())
+
+	c.JSON(http.StatusOK, res)
+}
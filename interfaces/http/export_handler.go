@@ -0,0 +1,48 @@
+package http
+
+import (
+	"fmt"
+	"my-project/domain/dto"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IExportHandler interface {
+	StartExport(c *gin.Context)
+	GetExportStatus(c *gin.Context)
+	DownloadExport(c *gin.Context)
+}
+
+type ExportHandler struct {
+	exportUsecase usecase.IExportUsecase
+}
+
+func NewExportHandler(exportUsecase usecase.IExportUsecase) IExportHandler {
+	return &ExportHandler{exportUsecase: exportUsecase}
+}
+
+func (exportHandler *ExportHandler) StartExport(c *gin.Context) {
+	res := exportHandler.exportUsecase.StartExport(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (exportHandler *ExportHandler) GetExportStatus(c *gin.Context) {
+	res := exportHandler.exportUsecase.GetExportStatus(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (exportHandler *ExportHandler) DownloadExport(c *gin.Context) {
+	export, ok := exportHandler.exportUsecase.GetExport(c.Request.Context(), getUserId(c))
+	if !ok {
+		c.JSON(http.StatusNotFound, dto.Res{ResponseCode: "404", ResponseMessage: "Export is not ready."})
+		return
+	}
+
+	fileName := fmt.Sprintf("account-export-%s.json", export.ExportedAt.Format("20060102150405"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fileName))
+	c.IndentedJSON(http.StatusOK, export)
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"my-project/domain/model"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getUserId returns the id of the user authenticated by middleware.Auth.
+func getUserId(c *gin.Context) int64 {
+	return c.GetInt64("user_id")
+}
+
+// getSessionId returns the session of the access token used for the request.
+func getSessionId(c *gin.Context) int64 {
+	return c.GetInt64("session_id")
+}
+
+func getSessionClient(c *gin.Context) model.SessionClient {
+	return model.SessionClient{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()}
+}
+
+func getIdParam(c *gin.Context, name string) (int64, error) {
+	return strconv.ParseInt(c.Param(name), 10, 64)
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"fmt"
+	"my-project/domain/dto"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ILoadTestHandler interface {
+	GetScenario(c *gin.Context)
+}
+
+type LoadTestHandler struct {
+	loadTestUsecase usecase.ILoadTestUsecase
+}
+
+func NewLoadTestHandler(loadTestUsecase usecase.ILoadTestUsecase) ILoadTestHandler {
+	return &LoadTestHandler{loadTestUsecase: loadTestUsecase}
+}
+
+// GetScenario returns load test targets for this server, authenticated with
+// the caller's own token. format=vegeta returns vegeta's text format; the
+// default is a JSON array for k6's http.batch.
+func (loadTestHandler *LoadTestHandler) GetScenario(c *gin.Context) {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	baseURL := fmt.Sprintf("%s://%s", scheme, c.Request.Host)
+	authorization := c.GetHeader("Authorization")
+
+	switch c.DefaultQuery("format", "k6") {
+	case "vegeta":
+		c.String(http.StatusOK, loadTestHandler.loadTestUsecase.GetVegetaTargets(c.Request.Context(), baseURL, authorization))
+	case "k6":
+		c.JSON(http.StatusOK, loadTestHandler.loadTestUsecase.GetScenario(c.Request.Context(), baseURL, authorization))
+	default:
+		c.JSON(http.StatusBadRequest, dto.Res{ResponseCode: "400", ResponseMessage: "Unknown format."})
+	}
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"my-project/domain/model"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type INoteHandler interface {
+	CreateNote(c *gin.Context)
+	GetNotes(c *gin.Context)
+	UpdateNote(c *gin.Context)
+	DeleteNote(c *gin.Context)
+}
+
+type NoteHandler struct {
+	noteUsecase usecase.INoteUsecase
+}
+
+func NewNoteHandler(noteUsecase usecase.INoteUsecase) INoteHandler {
+	return &NoteHandler{noteUsecase: noteUsecase}
+}
+
+func (noteHandler *NoteHandler) CreateNote(c *gin.Context) {
+	var req model.ReqVideoNote
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := noteHandler.noteUsecase.CreateNote(c.Request.Context(), getUserId(c), c.Param("videoId"), req)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (noteHandler *NoteHandler) GetNotes(c *gin.Context) {
+	res := noteHandler.noteUsecase.GetNotes(c.Request.Context(), getUserId(c), c.Param("videoId"))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (noteHandler *NoteHandler) UpdateNote(c *gin.Context) {
+	var req model.ReqVideoNote
+
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := noteHandler.noteUsecase.UpdateNote(c.Request.Context(), getUserId(c), c.Param("videoId"), id, req)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (noteHandler *NoteHandler) DeleteNote(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := noteHandler.noteUsecase.DeleteNote(c.Request.Context(), getUserId(c), c.Param("videoId"), id)
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"my-project/domain/dto"
+	"my-project/infrastructure/clients/oidc"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/logger"
+	"my-project/usecase"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const oidcStateCookie = "oidc_state"
+
+type IOIDCHandler interface {
+	Login(c *gin.Context)
+	Link(c *gin.Context)
+	Callback(c *gin.Context)
+}
+
+type OIDCHandler struct {
+	providers   map[string]oidc.IProvider
+	userUsecase usecase.IUserUsecase
+}
+
+func NewOIDCHandler(providers map[string]oidc.IProvider, userUsecase usecase.IUserUsecase) IOIDCHandler {
+	return &OIDCHandler{providers: providers, userUsecase: userUsecase}
+}
+
+// Login redirects to the provider's consent page. The state is kept in a
+// short-lived cookie and checked again on the callback.
+func (oidcHandler *OIDCHandler) Login(c *gin.Context) {
+	provider, ok := oidcHandler.providers[c.Param("provider")]
+	if !ok {
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "404", ResponseMessage: "Provider not found."})
+		return
+	}
+
+	state, err := newState()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while generating state")
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "500", ResponseMessage: "Internal server error"})
+		return
+	}
+
+	setStateCookie(c, state)
+	c.Redirect(http.StatusFound, provider.AuthCodeURL(state))
+}
+
+// Link starts the provider flow for the signed-in user and returns the
+// consent page URL. The callback then adds the identity to this account
+// instead of signing in.
+func (oidcHandler *OIDCHandler) Link(c *gin.Context) {
+	provider, ok := oidcHandler.providers[c.Param("provider")]
+	if !ok {
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "404", ResponseMessage: "Provider not found."})
+		return
+	}
+
+	nonce, err := newState()
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while generating state")
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "500", ResponseMessage: "Internal server error"})
+		return
+	}
+	state := linkState(nonce, getUserId(c))
+
+	setStateCookie(c, state)
+	c.JSON(http.StatusOK, dto.Res{ResponseCode: "200", ResponseMessage: "Success", Data: gin.H{"url": provider.AuthCodeURL(state)}})
+}
+
+func (oidcHandler *OIDCHandler) Callback(c *gin.Context) {
+	provider, ok := oidcHandler.providers[c.Param("provider")]
+	if !ok {
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "404", ResponseMessage: "Provider not found."})
+		return
+	}
+
+	state, err := c.Cookie(oidcStateCookie)
+	c.SetCookie(oidcStateCookie, "", -1, "/auth", "", c.Request.TLS != nil, true)
+	if err != nil || state == "" || state != c.Query("state") {
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "401", ResponseMessage: "Unautorized."})
+		return
+	}
+
+	identity, err := provider.Exchange(c.Request.Context(), c.Query("code"))
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while exchanging OIDC code")
+		c.JSON(http.StatusOK, dto.Res{ResponseCode: "401", ResponseMessage: "Unautorized."})
+		return
+	}
+
+	if userId, ok := parseLinkState(state); ok {
+		c.JSON(http.StatusOK, oidcHandler.userUsecase.LinkIdentity(c.Request.Context(), userId, identity))
+		return
+	}
+
+	res := oidcHandler.userUsecase.LoginWithIdentity(c.Request.Context(), identity, getSessionClient(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func newState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// setStateCookie keeps the state in a short-lived cookie so the callback
+// only completes in the browser that started the flow.
+func setStateCookie(c *gin.Context, state string) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, 600, "/auth", "", c.Request.TLS != nil, true)
+}
+
+// linkState extends a state with the account to link. The signature stops a
+// client from editing its own cookie to link an identity to another account.
+func linkState(nonce string, userId int64) string {
+	payload := nonce + "." + strconv.FormatInt(userId, 10)
+	return payload + "." + signState(payload)
+}
+
+// parseLinkState returns the account of a state made by linkState, or false
+// for a sign-in state.
+func parseLinkState(state string) (int64, bool) {
+	parts := strings.Split(state, ".")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signState(payload))) {
+		return 0, false
+	}
+	userId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || userId == 0 {
+		return 0, false
+	}
+	return userId, true
+}
+
+func signState(payload string) string {
+	mac := hmac.New(sha256.New, []byte(configuration.C.App.SecretKey))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
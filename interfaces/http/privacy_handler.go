@@ -0,0 +1,26 @@
+package http
+
+import (
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IPrivacyHandler interface {
+	DeleteMyData(c *gin.Context)
+}
+
+type PrivacyHandler struct {
+	privacyUsecase usecase.IPrivacyUsecase
+}
+
+func NewPrivacyHandler(privacyUsecase usecase.IPrivacyUsecase) IPrivacyHandler {
+	return &PrivacyHandler{privacyUsecase: privacyUsecase}
+}
+
+func (privacyHandler *PrivacyHandler) DeleteMyData(c *gin.Context) {
+	res := privacyHandler.privacyUsecase.DeleteMyData(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
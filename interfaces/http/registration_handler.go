@@ -0,0 +1,53 @@
+package http
+
+import (
+	"fmt"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IRegistrationHandler interface {
+	GetPendingRegistrations(c *gin.Context)
+	ApproveRegistration(c *gin.Context)
+	RejectRegistration(c *gin.Context)
+}
+
+type RegistrationHandler struct {
+	registrationUsecase usecase.IRegistrationUsecase
+}
+
+func NewRegistrationHandler(registrationUsecase usecase.IRegistrationUsecase) IRegistrationHandler {
+	return &RegistrationHandler{registrationUsecase: registrationUsecase}
+}
+
+func (registrationHandler *RegistrationHandler) GetPendingRegistrations(c *gin.Context) {
+	res := registrationHandler.registrationUsecase.GetPendingRegistrations(c.Request.Context())
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (registrationHandler *RegistrationHandler) ApproveRegistration(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := registrationHandler.registrationUsecase.ApproveRegistration(c.Request.Context(), id)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (registrationHandler *RegistrationHandler) RejectRegistration(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := registrationHandler.registrationUsecase.RejectRegistration(c.Request.Context(), id)
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"expvar"
+	"my-project/usecase"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IRuntimeHandler interface {
+	GetRuntime(c *gin.Context)
+	Vars(c *gin.Context)
+	Profile(c *gin.Context)
+}
+
+type RuntimeHandler struct {
+	runtimeUsecase usecase.IRuntimeUsecase
+}
+
+func NewRuntimeHandler(runtimeUsecase usecase.IRuntimeUsecase) IRuntimeHandler {
+	return &RuntimeHandler{runtimeUsecase: runtimeUsecase}
+}
+
+func (runtimeHandler *RuntimeHandler) GetRuntime(c *gin.Context) {
+	res := runtimeHandler.runtimeUsecase.GetRuntime(c.Request.Context())
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (runtimeHandler *RuntimeHandler) Vars(c *gin.Context) {
+	expvar.Handler().ServeHTTP(c.Writer, c.Request)
+}
+
+// Profile serves the net/http/pprof profiles by name, e.g. heap, goroutine,
+// block or profile for a CPU profile. The pprof index relies on the
+// /debug/pprof/ URL prefix, so profiles are dispatched by name instead.
+func (runtimeHandler *RuntimeHandler) Profile(c *gin.Context) {
+	switch name := c.Param("name"); name {
+	case "", "/":
+		pprof.Index(c.Writer, c.Request)
+	case "/cmdline":
+		pprof.Cmdline(c.Writer, c.Request)
+	case "/profile":
+		pprof.Profile(c.Writer, c.Request)
+	case "/symbol":
+		pprof.Symbol(c.Writer, c.Request)
+	case "/trace":
+		pprof.Trace(c.Writer, c.Request)
+	default:
+		pprof.Handler(name[1:]).ServeHTTP(c.Writer, c.Request)
+	}
+}
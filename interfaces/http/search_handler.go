@@ -0,0 +1,99 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"my-project/domain/model"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ISearchHandler interface {
+	RecordSearch(c *gin.Context)
+	GetHistory(c *gin.Context)
+	DeleteHistory(c *gin.Context)
+	ClearHistory(c *gin.Context)
+	SaveSearch(c *gin.Context)
+	GetSavedSearches(c *gin.Context)
+	DeleteSavedSearch(c *gin.Context)
+}
+
+type SearchHandler struct {
+	searchUsecase usecase.ISearchUsecase
+}
+
+func NewSearchHandler(searchUsecase usecase.ISearchUsecase) ISearchHandler {
+	return &SearchHandler{searchUsecase: searchUsecase}
+}
+
+func (searchHandler *SearchHandler) RecordSearch(c *gin.Context) {
+	var req model.ReqSearchHistory
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := searchHandler.searchUsecase.RecordSearch(c.Request.Context(), getUserId(c), req)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (searchHandler *SearchHandler) GetHistory(c *gin.Context) {
+	res := searchHandler.searchUsecase.GetHistory(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (searchHandler *SearchHandler) DeleteHistory(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := searchHandler.searchUsecase.DeleteHistory(c.Request.Context(), getUserId(c), id)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (searchHandler *SearchHandler) ClearHistory(c *gin.Context) {
+	res := searchHandler.searchUsecase.ClearHistory(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (searchHandler *SearchHandler) SaveSearch(c *gin.Context) {
+	var req model.ReqSavedSearch
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := searchHandler.searchUsecase.SaveSearch(c.Request.Context(), getUserId(c), req)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (searchHandler *SearchHandler) GetSavedSearches(c *gin.Context) {
+	res := searchHandler.searchUsecase.GetSavedSearches(c.Request.Context(), getUserId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (searchHandler *SearchHandler) DeleteSavedSearch(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := searchHandler.searchUsecase.DeleteSavedSearch(c.Request.Context(), getUserId(c), id)
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"my-project/domain/dto"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ISeedHandler interface {
+	Seed(c *gin.Context)
+}
+
+type SeedHandler struct {
+	seedUsecase usecase.ISeedUsecase
+}
+
+func NewSeedHandler(seedUsecase usecase.ISeedUsecase) ISeedHandler {
+	return &SeedHandler{seedUsecase: seedUsecase}
+}
+
+func (seedHandler *SeedHandler) Seed(c *gin.Context) {
+	var req dto.Fixtures
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := seedHandler.seedUsecase.Seed(c.Request.Context(), req)
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"fmt"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ISessionHandler interface {
+	GetSessions(c *gin.Context)
+	RevokeSession(c *gin.Context)
+}
+
+type SessionHandler struct {
+	sessionUsecase usecase.ISessionUsecase
+}
+
+func NewSessionHandler(sessionUsecase usecase.ISessionUsecase) ISessionHandler {
+	return &SessionHandler{sessionUsecase: sessionUsecase}
+}
+
+func (sessionHandler *SessionHandler) GetSessions(c *gin.Context) {
+	res := sessionHandler.sessionUsecase.GetSessions(c.Request.Context(), getUserId(c), getSessionId(c))
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (sessionHandler *SessionHandler) RevokeSession(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := sessionHandler.sessionUsecase.RevokeSession(c.Request.Context(), getUserId(c), id)
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"my-project/domain/model"
+	"my-project/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IStatusHandler interface {
+	GetStatus(c *gin.Context)
+	CreateIncident(c *gin.Context)
+	ResolveIncident(c *gin.Context)
+}
+
+type StatusHandler struct {
+	statusUsecase usecase.IStatusUsecase
+}
+
+func NewStatusHandler(statusUsecase usecase.IStatusUsecase) IStatusHandler {
+	return &StatusHandler{statusUsecase: statusUsecase}
+}
+
+// GetStatus is served without authentication. Status pages poll it, so
+// responses may be cached briefly.
+func (statusHandler *StatusHandler) GetStatus(c *gin.Context) {
+	res := statusHandler.statusUsecase.GetStatus(c.Request.Context())
+
+	c.Header("Cache-Control", "public, max-age=30")
+	c.JSON(http.StatusOK, res)
+}
+
+func (statusHandler *StatusHandler) CreateIncident(c *gin.Context) {
+	var req model.ReqIncident
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("An error occurred: %v", err)
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := statusHandler.statusUsecase.CreateIncident(c.Request.Context(), req)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (statusHandler *StatusHandler) ResolveIncident(c *gin.Context) {
+	id, err := getIdParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := statusHandler.statusUsecase.ResolveIncident(c.Request.Context(), id)
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"fmt"
+	"my-project/usecase"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IUsageHandler interface {
+	GetMyUsage(c *gin.Context)
+	GetUsageBreakdown(c *gin.Context)
+}
+
+type UsageHandler struct {
+	usageUsecase usecase.IUsageUsecase
+}
+
+func NewUsageHandler(usageUsecase usecase.IUsageUsecase) IUsageHandler {
+	return &UsageHandler{usageUsecase: usageUsecase}
+}
+
+func (usageHandler *UsageHandler) GetMyUsage(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := usageHandler.usageUsecase.GetMyUsage(c.Request.Context(), getUserId(c), days)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (usageHandler *UsageHandler) GetUsageBreakdown(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, fmt.Sprintf("An error occurred: %v", err.Error()))
+		return
+	}
+
+	res := usageHandler.usageUsecase.GetUsageBreakdown(c.Request.Context(), days)
+
+	c.JSON(http.StatusOK, res)
+}
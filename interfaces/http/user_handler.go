@@ -15,6 +15,7 @@ import (
 type IUserHandler interface {
 	Login(c *gin.Context)
 	Register(c *gin.Context)
+	VerifyEmail(c *gin.Context)
 }
 
 type UserHandler struct {
@@ -35,6 +36,7 @@ func (userHandler *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
+	req.Client = getSessionClient(c)
 	res := userHandler.userUsecase.Login(c.Request.Context(), req)
 
 	c.JSON(http.StatusOK, res)
@@ -54,3 +56,9 @@ func (userHandler *UserHandler) Register(c *gin.Context) {
 
 	c.JSON(http.StatusOK, res)
 }
+
+func (userHandler *UserHandler) VerifyEmail(c *gin.Context) {
+	res := userHandler.userUsecase.VerifyEmail(c.Request.Context(), c.Query("token"))
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// routeScopes maps the routes personal access tokens may call to their read
+// and write scopes. Safe methods need the read scope, everything else the
+// write scope. Routes not listed here, such as account, session, token and
+// admin management, are only available to session tokens.
+var routeScopes = map[string][2]string{
+	"/api/searches/history":          {model.ScopeReadSearches, model.ScopeWriteSearches},
+	"/api/searches/history/:id":      {model.ScopeReadSearches, model.ScopeWriteSearches},
+	"/api/searches/saved":            {model.ScopeReadSearches, model.ScopeWriteSearches},
+	"/api/searches/saved/:id":        {model.ScopeReadSearches, model.ScopeWriteSearches},
+	"/api/bookmarks":                 {model.ScopeReadBookmarks, model.ScopeWriteBookmarks},
+	"/api/videos/:videoId/bookmark":  {model.ScopeReadBookmarks, model.ScopeWriteBookmarks},
+	"/api/videos/:videoId/notes":     {model.ScopeReadNotes, model.ScopeWriteNotes},
+	"/api/videos/:videoId/notes/:id": {model.ScopeReadNotes, model.ScopeWriteNotes},
+	"/api/me/usage":                  {model.ScopeReadUsage, ""},
+}
+
+// requiredScope returns the scope a token needs for the request, or false
+// when tokens may not call the route at all.
+func requiredScope(method string, route string) (string, bool) {
+	scopes, ok := routeScopes[route]
+	if !ok {
+		return "", false
+	}
+	scope := scopes[1]
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		scope = scopes[0]
+	}
+	return scope, scope != ""
+}
+
+// authenticateAccessToken handles requests carrying a personal access token
+// instead of a session JWT.
+func authenticateAccessToken(ctx *gin.Context, raw string, userRepository repository.IUser, accessTokenRepository repository.IAccessToken) {
+	var res dto.Res
+	res.ResponseCode = "401"
+	res.ResponseMessage = "Unautorized"
+
+	token, err := accessTokenRepository.GetTokenByHash(ctx.Request.Context(), model.HashAccessToken(raw))
+	if err != nil {
+		res.ResponseMessage = "Invalid access token"
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, res)
+		return
+	}
+
+	scope, ok := requiredScope(ctx.Request.Method, ctx.FullPath())
+	if !ok || !token.HasScope(scope) {
+		res.ResponseCode = "403"
+		res.ResponseMessage = "Access token does not have the required scope."
+		ctx.AbortWithStatusJSON(http.StatusForbidden, res)
+		return
+	}
+
+	user, err := userRepository.GetById(ctx.Request.Context(), int(token.UserID))
+	if err != nil || user.InactiveReason() != "" {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, res)
+		return
+	}
+
+	ctx.Set("user_id", user.ID)
+	ctx.Set("user_name", user.UserName)
+	ctx.Set("access_token_id", token.ID)
+	if err := accessTokenRepository.TouchToken(ctx.Request.Context(), token.ID); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while update access token activity")
+	}
+	ctx.Next()
+}
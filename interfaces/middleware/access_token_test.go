@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"my-project/mocks/repomocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthAccessToken(t *testing.T) {
+	const raw = model.AccessTokenPrefix + "secret"
+
+	gin.SetMode(gin.TestMode)
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetById", mock.Anything, 1).Return(model.User{ID: 1, UserName: "lamboktulus1379", Status: model.UserStatusActive}, nil)
+	accessTokenRepository := &repomocks.IAccessToken{}
+	accessTokenRepository.On("GetTokenByHash", mock.Anything, model.HashAccessToken(raw)).Return(model.AccessToken{ID: 3, UserID: 1, Scopes: []string{model.ScopeReadNotes}}, nil)
+	accessTokenRepository.On("GetTokenByHash", mock.Anything, mock.Anything).Return(model.AccessToken{}, sql.ErrNoRows)
+	accessTokenRepository.On("TouchToken", mock.Anything, int64(3)).Return(nil)
+
+	router := gin.New()
+	api := router.Group("api")
+	api.Use(Auth(userRepository, &repomocks.ISession{}, cache.NewSessionCache(nil), accessTokenRepository))
+	ok := func(ctx *gin.Context) { ctx.String(http.StatusOK, "%d", ctx.GetInt64("user_id")) }
+	api.GET("/videos/:videoId/notes", ok)
+	api.POST("/videos/:videoId/notes", ok)
+	api.GET("/me/sessions", ok)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{name: "granted scope", method: http.MethodGet, path: "/api/videos/a/notes", token: raw, want: http.StatusOK},
+		{name: "missing write scope", method: http.MethodPost, path: "/api/videos/a/notes", token: raw, want: http.StatusForbidden},
+		{name: "session only route", method: http.MethodGet, path: "/api/me/sessions", token: raw, want: http.StatusForbidden},
+		{name: "unknown token", method: http.MethodGet, path: "/api/videos/a/notes", token: model.AccessTokenPrefix + "other", want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Code)
+			if tt.want == http.StatusOK {
+				assert.Equal(t, "1", rec.Body.String())
+			}
+		})
+	}
+}
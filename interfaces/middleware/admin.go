@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"my-project/domain/dto"
+	"my-project/infrastructure/configuration"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Admin only lets through users listed in app.admins. It must run after Auth.
+func Admin() gin.HandlerFunc {
+	var res dto.Res
+	res.ResponseCode = "403"
+	res.ResponseMessage = "Forbidden"
+
+	return func(ctx *gin.Context) {
+		userName := ctx.GetString("user_name")
+		for _, admin := range configuration.C.App.Admins {
+			if userName != "" && admin == userName {
+				ctx.Next()
+				return
+			}
+		}
+		ctx.AbortWithStatusJSON(http.StatusForbidden, res)
+	}
+}
@@ -1,11 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"my-project/domain/dto"
 	"my-project/domain/model"
 	"my-project/domain/repository"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/logger"
 	"net/http"
 	"os"
 	"strings"
@@ -14,14 +17,11 @@ import (
 	"github.com/golang-jwt/jwt"
 )
 
-func Auth(userRepository repository.IUser) gin.HandlerFunc {
-
-	var res dto.Res
-	res.ResponseCode = "401"
-	res.ResponseMessage = "Unautorized"
+func Auth(userRepository repository.IUser, sessionRepository repository.ISession, sessionCache cache.ISessionCache, accessTokenRepository repository.IAccessToken) gin.HandlerFunc {
 
 	log.Println("Inside auth middeware")
 	return func(ctx *gin.Context) {
+		res := dto.Res{ResponseCode: "401", ResponseMessage: "Unautorized"}
 
 		authorization := ctx.Request.Header.Get("Authorization")
 		secretKey := os.Getenv("SECRET_KEY")
@@ -30,26 +30,49 @@ func Auth(userRepository repository.IUser) gin.HandlerFunc {
 			return
 		}
 		auth := strings.Split(authorization, "Bearer ")
-		fmt.Println("Auth:", auth[1])
 		if len(auth) != 2 {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, res)
 			return
 		}
+		if strings.HasPrefix(auth[1], model.AccessTokenPrefix) {
+			authenticateAccessToken(ctx, auth[1], userRepository, accessTokenRepository)
+			return
+		}
 		var userClaims model.UserClaims
 		token, err := jwt.ParseWithClaims(auth[1], &userClaims, func(token *jwt.Token) (interface{}, error) {
 			return []byte(secretKey), nil
 		})
-		fmt.Printf("Claims: %+v\n", userClaims)
 
 		if token.Valid {
-			fmt.Println("You look nice today")
-			_, err := userRepository.GetByUserName(ctx.Request.Context(), userClaims.UserName)
+			revoked, err := sessionRevoked(ctx.Request.Context(), sessionRepository, sessionCache, userClaims.SessionID)
 			if err != nil {
-				fmt.Println("User not found")
+				logger.GetLogger().WithField("error", err).Error("Error while check session")
+				ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, dto.Res{
+					ResponseCode:    "503",
+					ResponseMessage: "Session could not be verified.",
+				})
+				return
+			}
+			if revoked {
+				res.ResponseMessage = "Session has been revoked"
 				ctx.AbortWithStatusJSON(http.StatusUnauthorized, res)
 				return
 			}
-			ctx.Set("user_id", userClaims.Issuer)
+			user, err := userRepository.GetByUserName(ctx.Request.Context(), userClaims.UserName)
+			if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusUnauthorized, res)
+				return
+			}
+			if reason := user.InactiveReason(); reason != "" {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, dto.Res{ResponseCode: "403", ResponseMessage: reason})
+				return
+			}
+			if sessionCache.TouchDue(ctx.Request.Context(), userClaims.SessionID) {
+				go touchSession(sessionRepository, userClaims.SessionID)
+			}
+			ctx.Set("user_id", user.ID)
+			ctx.Set("user_name", user.UserName)
+			ctx.Set("session_id", userClaims.SessionID)
 			ctx.Next()
 		} else if ve, ok := err.(*jwt.ValidationError); ok {
 			if ve.Errors&jwt.ValidationErrorMalformed != 0 {
@@ -69,3 +92,32 @@ func Auth(userRepository repository.IUser) gin.HandlerFunc {
 		}
 	}
 }
+
+// sessionRevoked trusts the revocation cache and only asks the database when
+// the cache cannot answer. Tokens without a session and lookups that fail
+// everywhere are rejected.
+func sessionRevoked(ctx context.Context, sessionRepository repository.ISession, sessionCache cache.ISessionCache, sessionId int64) (bool, error) {
+	if sessionId == 0 {
+		return true, nil
+	}
+	revoked, err := sessionCache.IsRevoked(ctx, sessionId)
+	if err == nil {
+		return revoked, nil
+	}
+	revoked, err = sessionRepository.IsSessionRevoked(ctx, sessionId)
+	if err != nil {
+		return true, err
+	}
+	if !revoked {
+		sessionCache.MarkActive(ctx, sessionId)
+	}
+	return revoked, nil
+}
+
+// touchSession records session activity outside the request, so a slow
+// database does not hold up authenticated calls.
+func touchSession(sessionRepository repository.ISession, sessionId int64) {
+	if err := sessionRepository.TouchSession(context.Background(), sessionId); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while update session activity")
+	}
+}
@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/utils"
+	"my-project/mocks/repomocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuthRouter(t *testing.T, userRepository *repomocks.IUser, sessionRepository *repomocks.ISession, sessionCache cache.ISessionCache) *gin.Engine {
+	t.Setenv("SECRET_KEY", "secret")
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Auth(userRepository, sessionRepository, sessionCache, &repomocks.IAccessToken{}))
+	router.GET("/me", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+	return router
+}
+
+func authRequest(t *testing.T, router *gin.Engine, sessionId int64) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	if sessionId != 0 {
+		token, err := utils.GenerateToken(map[string]interface{}{
+			"user_name": "lamboktulus1379",
+			"sid":       sessionId,
+			"exp":       time.Now().Add(time.Minute).Unix(),
+		}, "secret")
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func activeUserRepository() *repomocks.IUser {
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetByUserName", mock.Anything, "lamboktulus1379").Return(model.User{ID: 1, UserName: "lamboktulus1379", Status: model.UserStatusActive}, nil)
+	return userRepository
+}
+
+func TestAuthSessionRevocation(t *testing.T) {
+	tests := []struct {
+		name       string
+		revoked    bool
+		revokedErr error
+		want       int
+	}{
+		{name: "active session", want: http.StatusOK},
+		{name: "revoked in database", revoked: true, want: http.StatusUnauthorized},
+		{name: "database unavailable", revokedErr: errors.New("connection refused"), want: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionRepository := &repomocks.ISession{}
+			sessionRepository.On("IsSessionRevoked", mock.Anything, int64(7)).Return(tt.revoked, tt.revokedErr)
+			sessionRepository.On("TouchSession", mock.Anything, int64(7)).Return(nil).Maybe()
+			router := newAuthRouter(t, activeUserRepository(), sessionRepository, cache.NewSessionCache(nil))
+
+			rec := authRequest(t, router, 7)
+
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestAuthTrustsSessionCache(t *testing.T) {
+	sessionRepository := &repomocks.ISession{}
+	sessionRepository.On("IsSessionRevoked", mock.Anything, int64(7)).Return(false, nil).Once()
+	sessionRepository.On("TouchSession", mock.Anything, int64(7)).Return(nil).Maybe()
+	sessionCache := cache.NewSessionCache(nil)
+	router := newAuthRouter(t, activeUserRepository(), sessionRepository, sessionCache)
+
+	assert.Equal(t, http.StatusOK, authRequest(t, router, 7).Code)
+	assert.Equal(t, http.StatusOK, authRequest(t, router, 7).Code, "a confirmed session is served from the cache")
+
+	sessionCache.Revoke(context.Background(), 7)
+	assert.Equal(t, http.StatusUnauthorized, authRequest(t, router, 7).Code)
+	sessionRepository.AssertNumberOfCalls(t, "IsSessionRevoked", 1)
+}
+
+func TestAuthResponseIsPerRequest(t *testing.T) {
+	sessionCache := cache.NewSessionCache(nil)
+	sessionCache.Revoke(context.Background(), 7)
+	router := newAuthRouter(t, activeUserRepository(), &repomocks.ISession{}, sessionCache)
+
+	require.Equal(t, http.StatusUnauthorized, authRequest(t, router, 7).Code)
+
+	rec := authRequest(t, router, 0)
+	var res dto.Res
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	assert.Equal(t, "Unautorized", res.ResponseMessage)
+}
+
+func TestAuthRejectsInactiveUser(t *testing.T) {
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetByUserName", mock.Anything, "lamboktulus1379").Return(model.User{ID: 1, UserName: "lamboktulus1379", Status: model.UserStatusUnverified}, nil)
+	sessionCache := cache.NewSessionCache(nil)
+	sessionCache.MarkActive(context.Background(), 7)
+	router := newAuthRouter(t, userRepository, &repomocks.ISession{}, sessionCache)
+
+	rec := authRequest(t, router, 7)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"errors"
+	"my-project/domain/dto"
+	"my-project/infrastructure/clients/captcha"
+	"my-project/infrastructure/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const CaptchaHeader = "X-Captcha-Token"
+
+// Captcha requires a valid captcha token in the X-Captcha-Token header. It
+// lets every request through when no verifier is configured.
+func Captcha(verifier captcha.ICaptcha) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if verifier == nil {
+			ctx.Next()
+			return
+		}
+
+		err := verifier.Verify(ctx.Request.Context(), ctx.GetHeader(CaptchaHeader), ctx.ClientIP())
+		if errors.Is(err, captcha.ErrRejected) {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, dto.Res{
+				ResponseCode:    "400",
+				ResponseMessage: "Captcha verification failed.",
+			})
+			return
+		}
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while verify captcha")
+			ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, dto.Res{
+				ResponseCode:    "503",
+				ResponseMessage: "Captcha verification is unavailable.",
+			})
+			return
+		}
+
+		ctx.Next()
+	}
+}
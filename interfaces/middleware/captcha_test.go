@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"my-project/infrastructure/clients/captcha"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCaptcha struct {
+	err error
+}
+
+func (f fakeCaptcha) Verify(ctx context.Context, token string, remoteIP string) error {
+	if token == "" {
+		return captcha.ErrRejected
+	}
+	return f.err
+}
+
+func TestCaptcha(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifier captcha.ICaptcha
+		token    string
+		want     int
+	}{
+		{name: "disabled", verifier: nil, want: http.StatusOK},
+		{name: "valid token", verifier: fakeCaptcha{}, token: "good", want: http.StatusOK},
+		{name: "missing token", verifier: fakeCaptcha{}, want: http.StatusBadRequest},
+		{name: "provider down", verifier: fakeCaptcha{err: errors.New("timeout")}, token: "good", want: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.POST("/login", Captcha(tt.verifier), func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			if tt.token != "" {
+				req.Header.Set(CaptchaHeader, tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
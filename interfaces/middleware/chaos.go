@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"my-project/domain/dto"
+	"my-project/infrastructure/chaos"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Chaos delays and fails incoming requests at the injector's rates. It is a
+// no-op when injector is nil, which is always the case in production.
+func Chaos(injector *chaos.Injector) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if injector == nil {
+			ctx.Next()
+			return
+		}
+
+		if delay := injector.Delay(); delay > 0 {
+			select {
+			case <-ctx.Request.Context().Done():
+				return
+			case <-time.After(delay):
+			}
+		}
+
+		if fault := injector.Fault(); fault != chaos.None {
+			ctx.AbortWithStatusJSON(fault.StatusCode(), dto.Res{
+				ResponseCode:    strconv.Itoa(fault.StatusCode()),
+				ResponseMessage: "Injected fault.",
+			})
+			return
+		}
+
+		ctx.Next()
+	}
+}
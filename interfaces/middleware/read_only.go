@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"my-project/domain/dto"
+	"my-project/infrastructure/health"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReadOnly rejects mutating requests with 503 while the database is
+// unhealthy. Safe methods and the listed paths are always let through; GET
+// routes that write guard themselves with Writable.
+func ReadOnly(dbHealth health.IHealth, allowedPaths ...string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(allowedPaths))
+	for _, path := range allowedPaths {
+		allowed[path] = true
+	}
+
+	return func(ctx *gin.Context) {
+		if dbHealth.Healthy() || allowed[ctx.FullPath()] {
+			ctx.Next()
+			return
+		}
+
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			ctx.Next()
+		default:
+			abortReadOnly(ctx)
+		}
+	}
+}
+
+// Writable rejects every request to the route with 503 while the database is
+// unhealthy, whatever its method. It is meant for GET routes that change
+// state, such as email verification and OIDC callbacks.
+func Writable(dbHealth health.IHealth) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !dbHealth.Healthy() {
+			abortReadOnly(ctx)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+func abortReadOnly(ctx *gin.Context) {
+	ctx.Header("Retry-After", "30")
+	ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, dto.Res{
+		ResponseCode:    "503",
+		ResponseMessage: "Service is temporarily read-only, please try again later.",
+	})
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHealth bool
+
+func (h fakeHealth) Healthy() bool {
+	return bool(h)
+}
+
+func newReadOnlyRouter(healthy bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(ReadOnly(fakeHealth(healthy), "/healthz"))
+	ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+	router.GET("/notes", ok)
+	router.POST("/notes", ok)
+	router.POST("/healthz", ok)
+	router.GET("/verify-email", Writable(fakeHealth(healthy)), ok)
+	return router
+}
+
+func TestReadOnly(t *testing.T) {
+	tests := []struct {
+		name    string
+		healthy bool
+		method  string
+		path    string
+		want    int
+	}{
+		{name: "healthy mutation", healthy: true, method: http.MethodPost, path: "/notes", want: http.StatusOK},
+		{name: "unhealthy read", healthy: false, method: http.MethodGet, path: "/notes", want: http.StatusOK},
+		{name: "unhealthy mutation", healthy: false, method: http.MethodPost, path: "/notes", want: http.StatusServiceUnavailable},
+		{name: "unhealthy allowed path", healthy: false, method: http.MethodPost, path: "/healthz", want: http.StatusOK},
+		{name: "healthy writing read", healthy: true, method: http.MethodGet, path: "/verify-email", want: http.StatusOK},
+		{name: "unhealthy writing read", healthy: false, method: http.MethodGet, path: "/verify-email", want: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+
+			newReadOnlyRouter(tt.healthy).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Usage counts each authenticated request by user, route and UTC day. Routes
+// are recorded by their template so ids in the path do not split the counts.
+// It is a no-op when counter is nil.
+func Usage(counter cache.IUsageCounter) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		userId := ctx.GetInt64("user_id")
+		route := ctx.FullPath()
+		if counter == nil || userId == 0 || route == "" {
+			return
+		}
+		counter.Add(ctx.Request.Context(), model.APIUsage{
+			UserID:   userId,
+			Day:      time.Now().UTC().Truncate(24 * time.Hour),
+			Endpoint: ctx.Request.Method + " " + route,
+			Count:    1,
+		})
+	}
+}
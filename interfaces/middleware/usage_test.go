@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+	"my-project/infrastructure/cache"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	counter := cache.NewUsageCounter(nil)
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		if ctx.GetHeader("Authorization") != "" {
+			ctx.Set("user_id", int64(7))
+		}
+	})
+	router.Use(Usage(counter))
+	router.GET("/api/videos/:videoId/notes", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+
+	for _, path := range []string{"/api/videos/a/notes", "/api/videos/b/notes", "/api/unknown"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer token")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/videos/a/notes", nil))
+
+	usages, err := counter.Drain(context.Background())
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	assert.Equal(t, int64(7), usages[0].UserID)
+	assert.Equal(t, "GET /api/videos/:videoId/notes", usages[0].Endpoint)
+	assert.Equal(t, int64(2), usages[0].Count)
+}
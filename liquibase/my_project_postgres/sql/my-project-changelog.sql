@@ -14,3 +14,143 @@ create table public.user (
 )
 --rollback DROP TABLE public.user;
 
+
+--changeset lamboktulus1379:2 labels:my_project-label context:my_project-context
+--comment: search history and saved searches
+create table public.search_history (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    query varchar(255) not null,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+create index search_history_user_id_created_at_idx on public.search_history (user_id, created_at desc);
+create table public.saved_search (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    name varchar(100) not null,
+    query varchar(255) not null,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    unique (user_id, name)
+)
+--rollback DROP TABLE public.saved_search; DROP TABLE public.search_history;
+
+--changeset lamboktulus1379:3 labels:my_project-label context:my_project-context
+--comment: video bookmarks
+create table public.user_video_bookmarks (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    video_id varchar(64) not null,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    unique (user_id, video_id)
+)
+--rollback DROP TABLE public.user_video_bookmarks;
+
+--changeset lamboktulus1379:4 labels:my_project-label context:my_project-context
+--comment: private video notes
+create table public.video_notes (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    video_id varchar(64) not null,
+    user_id INT not null references public.user (id) on delete cascade,
+    body text not null,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+create index video_notes_user_id_video_id_idx on public.video_notes (user_id, video_id)
+--rollback DROP TABLE public.video_notes;
+
+--changeset lamboktulus1379:5 labels:my_project-label context:my_project-context
+--comment: email for OIDC account linking
+alter table public.user add column email varchar(255);
+alter table public.user alter column user_name type varchar(255);
+alter table public.user alter column name type varchar(255);
+create unique index user_email_lower_idx on public.user (lower(email))
+--rollback DROP INDEX public.user_email_lower_idx; ALTER TABLE public.user DROP COLUMN email; ALTER TABLE public.user ALTER COLUMN user_name TYPE varchar(50); ALTER TABLE public.user ALTER COLUMN name TYPE varchar(50);
+
+--changeset lamboktulus1379:6 labels:my_project-label context:my_project-context
+--comment: login sessions per device
+create table public.user_sessions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    user_agent varchar(512) not null default '',
+    ip_address varchar(64) not null default '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    last_seen_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+create index user_sessions_user_id_idx on public.user_sessions (user_id)
+--rollback DROP TABLE public.user_sessions;
+
+--changeset lamboktulus1379:7 labels:my_project-label context:my_project-context
+--comment: account status for the registration approval queue
+alter table public.user add column status varchar(16) not null default 'active';
+create index user_status_idx on public.user (status)
+--rollback DROP INDEX public.user_status_idx; ALTER TABLE public.user DROP COLUMN status;
+
+--changeset lamboktulus1379:8 labels:my_project-label context:my_project-context
+--comment: incident markers for the public status page
+create table public.status_incidents (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    title varchar(255) not null,
+    message text not null default '',
+    severity varchar(16) not null default 'minor',
+    started_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+create index status_incidents_started_at_idx on public.status_incidents (started_at)
+--rollback DROP TABLE public.status_incidents;
+
+--changeset lamboktulus1379:9 labels:my_project-label context:my_project-context
+--comment: per-user API request counts by endpoint and day
+create table public.api_usage (
+    user_id INT not null references public.user (id) on delete cascade,
+    day date not null,
+    endpoint varchar(255) not null,
+    count BIGINT not null default 0,
+    primary key (user_id, day, endpoint)
+);
+create index api_usage_day_idx on public.api_usage (day)
+--rollback DROP TABLE public.api_usage;
+
+--changeset lamboktulus1379:10 labels:my_project-label context:my_project-context
+--comment: scoped personal access tokens for third-party apps
+create table public.personal_access_tokens (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    name varchar(255) not null,
+    prefix varchar(16) not null,
+    token_hash char(64) not null unique,
+    scopes varchar(512) not null,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+create index personal_access_tokens_user_id_idx on public.personal_access_tokens (user_id)
+--rollback DROP TABLE public.personal_access_tokens;
+
+--changeset lamboktulus1379:11 labels:my_project-label context:my_project-context
+--comment: OIDC identities matched by provider and subject
+create table public.user_identities (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    provider varchar(32) not null,
+    subject varchar(255) not null,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    unique (provider, subject)
+);
+create index user_identities_user_id_idx on public.user_identities (user_id)
+--rollback DROP TABLE public.user_identities;
+
+--changeset lamboktulus1379:12 labels:my_project-label context:my_project-context
+--comment: pending confirmations of email addresses entered at registration
+create table public.email_verifications (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INT not null references public.user (id) on delete cascade,
+    email varchar(255) not null,
+    token_hash char(64) not null unique,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE not null
+);
+create index email_verifications_user_id_idx on public.email_verifications (user_id)
+--rollback DROP TABLE public.email_verifications;
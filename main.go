@@ -5,17 +5,27 @@ import (
 	"fmt"
 	"log"
 	"my-project/infrastructure/cache"
+	"my-project/infrastructure/chaos"
+	"my-project/infrastructure/clients"
+	"my-project/infrastructure/clients/captcha"
+	"my-project/infrastructure/clients/oidc"
 	tulushost "my-project/infrastructure/clients/tulustech"
 	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/doctor"
+	"my-project/infrastructure/feature"
+	"my-project/infrastructure/health"
 	"my-project/infrastructure/logger"
+	"my-project/infrastructure/mailer"
 	"my-project/infrastructure/persistence"
 	"my-project/infrastructure/pubsub"
 	"my-project/infrastructure/servicebus"
+	"my-project/infrastructure/worker"
 	httpHandler "my-project/interfaces/http"
 	"my-project/usecase"
 	"net/http"
 	"os"
 	"os/signal"
+	"runtime"
 	"syscall"
 	"time"
 
@@ -32,7 +42,26 @@ func recoverPanic() {
 	}
 }
 
+// checkEgress logs whether the hosts this deployment must reach answer
+// through the egress proxy. It only reports; startup carries on either way.
+func checkEgress(ctx context.Context) {
+	results := doctor.Run(ctx, []doctor.Check{doctor.Egress(configuration.C.Egress)}, 10*time.Second)
+	for _, result := range results {
+		entry := logger.GetLogger().WithField("host", result.Name).WithField("detail", result.Detail)
+		if result.Status == doctor.OK {
+			entry.Info("Egress host reachable")
+		} else {
+			entry.Warn("Egress host unreachable, run the doctor command for details")
+		}
+	}
+}
+
 func main() {
+	if code, ok := RunCommand(os.Args[1:]); ok {
+		os.Exit(code)
+	}
+
+	startedAt := time.Now()
 	InitiateGoroutine()
 	defer recoverPanic()
 	ctx := context.Background()
@@ -49,6 +78,9 @@ func main() {
 
 	app := configuration.C.App
 
+	runtime.SetBlockProfileRate(configuration.C.Diagnostics.BlockProfileRate)
+	runtime.SetMutexProfileFraction(configuration.C.Diagnostics.MutexProfileFraction)
+
 	mysqlDb, psqlDb, err := InitiateDatabase()
 	if err != nil {
 		panic(err)
@@ -56,38 +88,110 @@ func main() {
 
 	logger.GetLogger().WithField("MySQLDb", mysqlDb.Ping()).WithField("PSQLDb", psqlDb.Ping()).Info("Database connected.")
 
+	dbHealth := health.NewDBHealth(psqlDb)
+	dbHealth.Check(ctx)
+	go worker.RunEvery(ctx, 10*time.Second, dbHealth.Check)
+
+	features := feature.NewRegistry()
+
 	pubSubClient, err := pubsub.NewPubSub(ctx, configuration.C.Pubsub.ProjectID)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while instantiate PubSub")
 		// panic(err)
 	}
+	features.Set(feature.PubSub, pubSubClient != nil)
 
 	azServiceBusClient, err := servicebus.NewServiceBus(ctx, configuration.C.ServiceBus.Namespace)
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while instantiate ServiceBus")
-		panic(err)
 	}
-	redisClient, _ := cache.NewCache(ctx, fmt.Sprintf("%s:%s", configuration.C.RedisClient.Host, configuration.C.RedisClient.Port), configuration.C.RedisClient.Username, configuration.C.RedisClient.Password)
+	features.Set(feature.ServiceBus, azServiceBusClient != nil)
 
-	testCache := cache.NewTestCache(redisClient)
+	redisClient, err := cache.NewCache(ctx, fmt.Sprintf("%s:%s", configuration.C.RedisClient.Host, configuration.C.RedisClient.Port), configuration.C.RedisClient.Username, configuration.C.RedisClient.Password)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while instantiate Redis client")
+	} else {
+		logger.GetLogger().Info("Redis client initialized successfully.")
+	}
+	features.Set(feature.Cache, redisClient != nil)
 
-	logger.GetLogger().Info("Redis client initialized successfully.")
+	testCache := cache.NewTestCache(redisClient)
+	userCache := cache.NewUserCache(redisClient)
+	sessionCache := cache.NewSessionCache(redisClient)
+	var usageCounter cache.IUsageCounter
+	if configuration.C.Usage.FlushIntervalSeconds > 0 {
+		usageCounter = cache.NewUsageCounter(redisClient)
+	}
 
 	tulusTechHost := tulushost.NewTulusHost(configuration.C.TulusTech.Host)
+	features.Set(feature.TulusTech, configuration.C.TulusTech.Host != "")
+
+	captchaVerifier := captcha.NewVerifier(configuration.C.Captcha)
+	features.Set(feature.Captcha, captchaVerifier != nil)
+
+	chaosInjector := chaos.NewInjector(configuration.C.Chaos, os.Getenv("ENV"))
+	if chaosInjector != nil {
+		logger.GetLogger().WithField("chaos", configuration.C.Chaos).Warn("Fault injection is enabled")
+		clients.TransportWrapper = chaosInjector.Wrap
+	}
+
+	if len(configuration.C.Egress.RequiredHosts) > 0 {
+		go checkEgress(ctx)
+	}
 
 	testPubSub := pubsub.NewTestPubSub(pubSubClient)
 	testServiceBus := servicebus.NewTestServiceBus(azServiceBusClient)
 
 	userRepository := persistence.NewUserRepository(psqlDb)
-	userUsecase := usecase.NewUserUsecase(userRepository)
+	searchRepository := persistence.NewSearchRepository(psqlDb)
+	bookmarkRepository := persistence.NewBookmarkRepository(psqlDb)
+	noteRepository := persistence.NewNoteRepository(psqlDb)
+	privacyRepository := persistence.NewPrivacyRepository(psqlDb)
+	sessionRepository := persistence.NewSessionRepository(psqlDb)
+	incidentRepository := persistence.NewIncidentRepository(psqlDb)
+	usageRepository := persistence.NewUsageRepository(psqlDb)
+	accessTokenRepository := persistence.NewAccessTokenRepository(psqlDb)
+	identityRepository := persistence.NewIdentityRepository(psqlDb)
+	emailVerificationRepository := persistence.NewEmailVerificationRepository(psqlDb)
+	cachedUserRepository := persistence.NewCachedUserRepository(userRepository, userCache)
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, identityRepository, emailVerificationRepository, mailer.NewMailer(configuration.C.Mail))
+	searchUsecase := usecase.NewSearchUsecase(searchRepository)
+	bookmarkUsecase := usecase.NewBookmarkUsecase(bookmarkRepository)
+	noteUsecase := usecase.NewNoteUsecase(noteRepository)
+	privacyUsecase := usecase.NewPrivacyUsecase(privacyRepository, searchRepository, userRepository, userCache)
+	exportUsecase := usecase.NewExportUsecase(userRepository, searchRepository, bookmarkRepository, noteRepository, sessionRepository, accessTokenRepository, identityRepository)
+	capabilityUsecase := usecase.NewCapabilityUsecase(features, dbHealth)
+	seedUsecase := usecase.NewSeedUsecase(userRepository, persistence.NewSeedRepository(psqlDb))
+	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, sessionCache)
+	registrationUsecase := usecase.NewRegistrationUsecase(cachedUserRepository)
+	loadTestUsecase := usecase.NewLoadTestUsecase()
+	runtimeUsecase := usecase.NewRuntimeUsecase(startedAt)
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, features, dbHealth, startedAt)
+	usageUsecase := usecase.NewUsageUsecase(usageRepository, usageCounter)
+	accessTokenUsecase := usecase.NewAccessTokenUsecase(accessTokenRepository)
 	testUsecase := usecase.NewTestUsecase(tulusTechHost, testPubSub, testServiceBus, testCache)
 	testRes := testUsecase.Test(ctx)
 	fmt.Println("Test response", testRes)
 
 	userHandler := httpHandler.NewUserHandler(userUsecase)
 	testHandler := httpHandler.NewTestHandler(testUsecase)
-
-	router := InitiateRouter(userHandler, testHandler, userRepository)
+	searchHandler := httpHandler.NewSearchHandler(searchUsecase)
+	bookmarkHandler := httpHandler.NewBookmarkHandler(bookmarkUsecase)
+	noteHandler := httpHandler.NewNoteHandler(noteUsecase)
+	privacyHandler := httpHandler.NewPrivacyHandler(privacyUsecase)
+	exportHandler := httpHandler.NewExportHandler(exportUsecase)
+	capabilityHandler := httpHandler.NewCapabilityHandler(capabilityUsecase)
+	seedHandler := httpHandler.NewSeedHandler(seedUsecase)
+	oidcHandler := httpHandler.NewOIDCHandler(oidc.NewProviders(configuration.C.OIDC), userUsecase)
+	sessionHandler := httpHandler.NewSessionHandler(sessionUsecase)
+	registrationHandler := httpHandler.NewRegistrationHandler(registrationUsecase)
+	loadTestHandler := httpHandler.NewLoadTestHandler(loadTestUsecase)
+	runtimeHandler := httpHandler.NewRuntimeHandler(runtimeUsecase)
+	statusHandler := httpHandler.NewStatusHandler(statusUsecase)
+	usageHandler := httpHandler.NewUsageHandler(usageUsecase)
+	accessTokenHandler := httpHandler.NewAccessTokenHandler(accessTokenUsecase)
+
+	router := InitiateRouter(userHandler, testHandler, searchHandler, bookmarkHandler, noteHandler, privacyHandler, exportHandler, capabilityHandler, seedHandler, oidcHandler, sessionHandler, registrationHandler, loadTestHandler, runtimeHandler, statusHandler, usageHandler, accessTokenHandler, captchaVerifier, chaosInjector, cachedUserRepository, sessionRepository, accessTokenRepository, sessionCache, usageCounter, dbHealth)
 
 	if err != nil {
 		logger.GetLogger().WithField("error", err).Error("Error while StartSubscription")
@@ -100,6 +204,15 @@ func main() {
 		cancel()
 	}()
 
+	if interval := configuration.C.Retention.CleanupIntervalMinutes; interval > 0 {
+		go worker.RunEvery(ctx, time.Duration(interval)*time.Minute, privacyUsecase.CleanupExpired)
+		go worker.RunEvery(ctx, time.Duration(interval)*time.Minute, sessionUsecase.CleanupExpired)
+	}
+
+	if interval := configuration.C.Usage.FlushIntervalSeconds; interval > 0 {
+		go worker.RunEvery(ctx, time.Duration(interval)*time.Second, usageUsecase.Flush)
+	}
+
 	port := app.Port
 	logger.GetLogger().WithField("port", port).Info("Starting application")
 	g.Go(func() error {
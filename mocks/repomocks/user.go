@@ -28,6 +28,30 @@ func (_m *IUser) CreateUser(ctx context.Context, user model.User) error {
 	return r0
 }
 
+// GetByEmail provides a mock function with given fields: ctx, email
+func (_m *IUser) GetByEmail(ctx context.Context, email string) (model.User, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: ctx, id
 func (_m *IUser) GetById(ctx context.Context, id int) (model.User, error) {
 	ret := _m.Called(ctx, id)
@@ -52,6 +76,32 @@ func (_m *IUser) GetById(ctx context.Context, id int) (model.User, error) {
 	return r0, r1
 }
 
+// GetByStatus provides a mock function with given fields: ctx, status
+func (_m *IUser) GetByStatus(ctx context.Context, status string) ([]model.User, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.User, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.User); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByUserName provides a mock function with given fields: ctx, userName
 func (_m *IUser) GetByUserName(ctx context.Context, userName string) (model.User, error) {
 	ret := _m.Called(ctx, userName)
@@ -76,6 +126,20 @@ func (_m *IUser) GetByUserName(ctx context.Context, userName string) (model.User
 	return r0, r1
 }
 
+// UpdateStatus provides a mock function with given fields: ctx, id, from, to
+func (_m *IUser) UpdateStatus(ctx context.Context, id int64, from string, to string) error {
+	ret := _m.Called(ctx, id, from, to)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) error); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIUser creates a new instance of IUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIUser(t interface {
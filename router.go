@@ -2,6 +2,10 @@ package main
 
 import (
 	"my-project/domain/repository"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/chaos"
+	"my-project/infrastructure/clients/captcha"
+	"my-project/infrastructure/health"
 	httpHandler "my-project/interfaces/http"
 	"my-project/interfaces/middleware"
 	"net/http"
@@ -11,13 +15,13 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func InitiateRouter(userHandler httpHandler.IUserHandler, testHandler httpHandler.ITestHandler, userRepository repository.IUser) *gin.Engine {
+func InitiateRouter(userHandler httpHandler.IUserHandler, testHandler httpHandler.ITestHandler, searchHandler httpHandler.ISearchHandler, bookmarkHandler httpHandler.IBookmarkHandler, noteHandler httpHandler.INoteHandler, privacyHandler httpHandler.IPrivacyHandler, exportHandler httpHandler.IExportHandler, capabilityHandler httpHandler.ICapabilityHandler, seedHandler httpHandler.ISeedHandler, oidcHandler httpHandler.IOIDCHandler, sessionHandler httpHandler.ISessionHandler, registrationHandler httpHandler.IRegistrationHandler, loadTestHandler httpHandler.ILoadTestHandler, runtimeHandler httpHandler.IRuntimeHandler, statusHandler httpHandler.IStatusHandler, usageHandler httpHandler.IUsageHandler, accessTokenHandler httpHandler.IAccessTokenHandler, captchaVerifier captcha.ICaptcha, chaosInjector *chaos.Injector, userRepository repository.IUser, sessionRepository repository.ISession, accessTokenRepository repository.IAccessToken, sessionCache cache.ISessionCache, usageCounter cache.IUsageCounter, dbHealth health.IHealth) *gin.Engine {
 	router := gin.New()
 	router.Use(gin.Recovery())
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"https://tulus.tech"},
 		AllowMethods:     []string{"PUT", "PATCH"},
-		AllowHeaders:     []string{"Origin"},
+		AllowHeaders:     []string{"Origin", middleware.CaptchaHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		AllowOriginFunc: func(origin string) bool {
@@ -25,19 +29,72 @@ func InitiateRouter(userHandler httpHandler.IUserHandler, testHandler httpHandle
 		},
 		MaxAge: 12 * time.Hour,
 	}))
+	router.Use(middleware.ReadOnly(dbHealth, "/healthz"))
 
 	api := router.Group("api")
-	api.Use(middleware.Auth(userRepository))
+	api.Use(middleware.Auth(userRepository, sessionRepository, sessionCache, accessTokenRepository))
+	api.Use(middleware.Chaos(chaosInjector))
+	api.Use(middleware.Usage(usageCounter))
 
-	router.POST("/login", userHandler.Login)
-	router.POST("/register", userHandler.Register)
+	router.POST("/login", middleware.Captcha(captchaVerifier), userHandler.Login)
+	router.POST("/register", middleware.Captcha(captchaVerifier), userHandler.Register)
+	router.GET("/verify-email", middleware.Writable(dbHealth), userHandler.VerifyEmail)
+	router.GET("/auth/:provider/login", oidcHandler.Login)
+	router.GET("/auth/:provider/callback", middleware.Writable(dbHealth), oidcHandler.Callback)
 
 	router.POST("/healthz", testHandler.Test)
+	router.GET("/status", statusHandler.GetStatus)
 
 	api.POST("/", func(ctx *gin.Context) {
 		res := ctx.Request.Body
 		ctx.JSON(http.StatusOK, res)
 	})
 
+	api.GET("/searches/history", searchHandler.GetHistory)
+	api.POST("/searches/history", searchHandler.RecordSearch)
+	api.DELETE("/searches/history", searchHandler.ClearHistory)
+	api.DELETE("/searches/history/:id", searchHandler.DeleteHistory)
+	api.GET("/searches/saved", searchHandler.GetSavedSearches)
+	api.POST("/searches/saved", searchHandler.SaveSearch)
+	api.DELETE("/searches/saved/:id", searchHandler.DeleteSavedSearch)
+
+	api.GET("/bookmarks", bookmarkHandler.GetBookmarks)
+	api.PUT("/videos/:videoId/bookmark", bookmarkHandler.Bookmark)
+	api.DELETE("/videos/:videoId/bookmark", bookmarkHandler.Unbookmark)
+
+	api.GET("/videos/:videoId/notes", noteHandler.GetNotes)
+	api.POST("/videos/:videoId/notes", noteHandler.CreateNote)
+	api.PUT("/videos/:videoId/notes/:id", noteHandler.UpdateNote)
+	api.DELETE("/videos/:videoId/notes/:id", noteHandler.DeleteNote)
+
+	api.GET("/capabilities", capabilityHandler.GetCapabilities)
+
+	api.DELETE("/me", privacyHandler.DeleteMyData)
+	api.GET("/me/sessions", sessionHandler.GetSessions)
+	api.DELETE("/me/sessions/:id", sessionHandler.RevokeSession)
+	api.GET("/me/usage", usageHandler.GetMyUsage)
+	api.POST("/me/identities/:provider", oidcHandler.Link)
+	api.GET("/me/tokens", accessTokenHandler.GetTokens)
+	api.POST("/me/tokens", accessTokenHandler.CreateToken)
+	api.DELETE("/me/tokens/:id", accessTokenHandler.RevokeToken)
+	api.POST("/me/export", exportHandler.StartExport)
+	api.GET("/me/export", exportHandler.GetExportStatus)
+	api.GET("/me/export/download", exportHandler.DownloadExport)
+
+	admin := api.Group("admin")
+	admin.Use(middleware.Admin())
+
+	admin.POST("/seed", seedHandler.Seed)
+	admin.GET("/registrations", registrationHandler.GetPendingRegistrations)
+	admin.POST("/registrations/:id/approve", registrationHandler.ApproveRegistration)
+	admin.POST("/registrations/:id/reject", registrationHandler.RejectRegistration)
+	admin.GET("/loadtest/scenario", loadTestHandler.GetScenario)
+	admin.GET("/runtime", runtimeHandler.GetRuntime)
+	admin.GET("/debug/vars", runtimeHandler.Vars)
+	admin.GET("/debug/pprof/*name", runtimeHandler.Profile)
+	admin.POST("/incidents", statusHandler.CreateIncident)
+	admin.POST("/incidents/:id/resolve", statusHandler.ResolveIncident)
+	admin.GET("/usage", usageHandler.GetUsageBreakdown)
+
 	return router
 }
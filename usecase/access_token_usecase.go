@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+// accessTokenPrefixLength is how much of a token is kept in clear text for
+// users to recognise it by.
+const accessTokenPrefixLength = 12
+
+type IAccessTokenUsecase interface {
+	GetTokens(ctx context.Context, userId int64) dto.Res
+	CreateToken(ctx context.Context, userId int64, req model.ReqAccessToken) dto.Res
+	RevokeToken(ctx context.Context, userId int64, id int64) dto.Res
+}
+
+type AccessTokenUsecase struct {
+	accessTokenRepository repository.IAccessToken
+}
+
+func NewAccessTokenUsecase(accessTokenRepository repository.IAccessToken) IAccessTokenUsecase {
+	return &AccessTokenUsecase{accessTokenRepository: accessTokenRepository}
+}
+
+func (accessTokenUsecase *AccessTokenUsecase) GetTokens(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	tokens, err := accessTokenUsecase.accessTokenRepository.GetTokensByUserId(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get access tokens")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = tokens
+	return res
+}
+
+func (accessTokenUsecase *AccessTokenUsecase) CreateToken(ctx context.Context, userId int64, req model.ReqAccessToken) dto.Res {
+	var res dto.Res
+
+	for _, scope := range req.Scopes {
+		if !validScope(scope) {
+			res.ResponseCode = "400"
+			res.ResponseMessage = fmt.Sprintf("Unknown scope: %s.", scope)
+			return res
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while generate access token")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	raw := model.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	token := model.AccessToken{
+		UserID:    userId,
+		Name:      req.Name,
+		Prefix:    raw[:accessTokenPrefixLength],
+		TokenHash: model.HashAccessToken(raw),
+		Scopes:    req.Scopes,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	token, err := accessTokenUsecase.accessTokenRepository.CreateToken(ctx, token)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while create access token")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = dto.CreatedAccessToken{AccessToken: token, Token: raw}
+	return res
+}
+
+func (accessTokenUsecase *AccessTokenUsecase) RevokeToken(ctx context.Context, userId int64, id int64) dto.Res {
+	var res dto.Res
+
+	err := accessTokenUsecase.accessTokenRepository.RevokeToken(ctx, userId, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Access token not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while revoke access token")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+func validScope(scope string) bool {
+	for _, known := range model.Scopes {
+		if scope == known {
+			return true
+		}
+	}
+	return false
+}
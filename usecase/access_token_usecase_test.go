@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccessTokenUsecase_CreateToken(t *testing.T) {
+	accessTokenRepository := &repomocks.IAccessToken{}
+	accessTokenRepository.On("CreateToken", context.Background(), mock.AnythingOfType("model.AccessToken")).
+		Return(func(ctx context.Context, token model.AccessToken) (model.AccessToken, error) {
+			token.ID = 3
+			return token, nil
+		}).Once()
+
+	accessTokenUsecase := usecase.NewAccessTokenUsecase(accessTokenRepository)
+	response := accessTokenUsecase.CreateToken(context.Background(), 1, model.ReqAccessToken{
+		Name:          "CI",
+		Scopes:        []string{model.ScopeReadNotes},
+		ExpiresInDays: 30,
+	})
+
+	created := response.Data.(dto.CreatedAccessToken)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, int64(3), created.ID)
+	assert.True(t, strings.HasPrefix(created.Token, model.AccessTokenPrefix))
+	assert.True(t, strings.HasPrefix(created.Token, created.Prefix))
+	assert.Equal(t, model.HashAccessToken(created.Token), created.TokenHash)
+	assert.NotNil(t, created.ExpiresAt)
+}
+
+func TestAccessTokenUsecase_CreateTokenUnknownScope(t *testing.T) {
+	accessTokenUsecase := usecase.NewAccessTokenUsecase(&repomocks.IAccessToken{})
+	response := accessTokenUsecase.CreateToken(context.Background(), 1, model.ReqAccessToken{
+		Name:   "CI",
+		Scopes: []string{model.ScopeReadNotes, "admin"},
+	})
+
+	assert.Equal(t, "400", response.ResponseCode)
+	assert.Equal(t, "Unknown scope: admin.", response.ResponseMessage)
+}
+
+func TestAccessTokenUsecase_RevokeTokenNotFound(t *testing.T) {
+	accessTokenRepository := &repomocks.IAccessToken{}
+	accessTokenRepository.On("RevokeToken", context.Background(), int64(1), int64(3)).Return(sql.ErrNoRows).Once()
+
+	response := usecase.NewAccessTokenUsecase(accessTokenRepository).RevokeToken(context.Background(), 1, 3)
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type IBookmarkUsecase interface {
+	Bookmark(ctx context.Context, userId int64, videoId string) dto.Res
+	Unbookmark(ctx context.Context, userId int64, videoId string) dto.Res
+	GetBookmarks(ctx context.Context, userId int64) dto.Res
+}
+
+type BookmarkUsecase struct {
+	bookmarkRepository repository.IBookmark
+}
+
+func NewBookmarkUsecase(bookmarkRepository repository.IBookmark) IBookmarkUsecase {
+	return &BookmarkUsecase{bookmarkRepository: bookmarkRepository}
+}
+
+func (bookmarkUsecase *BookmarkUsecase) Bookmark(ctx context.Context, userId int64, videoId string) dto.Res {
+	var res dto.Res
+
+	err := bookmarkUsecase.bookmarkRepository.CreateBookmark(ctx, model.VideoBookmark{UserID: userId, VideoID: videoId})
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("video_id", videoId).Error("Error while create bookmark")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+func (bookmarkUsecase *BookmarkUsecase) Unbookmark(ctx context.Context, userId int64, videoId string) dto.Res {
+	var res dto.Res
+
+	err := bookmarkUsecase.bookmarkRepository.DeleteBookmark(ctx, userId, videoId)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Bookmark not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("video_id", videoId).Error("Error while delete bookmark")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+func (bookmarkUsecase *BookmarkUsecase) GetBookmarks(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	bookmarks, err := bookmarkUsecase.bookmarkRepository.GetBookmarksByUserId(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get bookmarks")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = bookmarks
+	return res
+}
@@ -0,0 +1,32 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookmarkUsecase_BookmarkSuccess(t *testing.T) {
+	bookmarkRepository := &repomocks.IBookmark{}
+	bookmarkRepository.On("CreateBookmark", context.Background(), model.VideoBookmark{UserID: 1, VideoID: "dQw4w9WgXcQ"}).Return(nil).Once()
+
+	bookmarkUsecase := usecase.NewBookmarkUsecase(bookmarkRepository)
+	response := bookmarkUsecase.Bookmark(context.Background(), 1, "dQw4w9WgXcQ")
+
+	assert.Equal(t, "200", response.ResponseCode)
+}
+
+func TestBookmarkUsecase_UnbookmarkNotFound(t *testing.T) {
+	bookmarkRepository := &repomocks.IBookmark{}
+	bookmarkRepository.On("DeleteBookmark", context.Background(), int64(1), "dQw4w9WgXcQ").Return(sql.ErrNoRows).Once()
+
+	bookmarkUsecase := usecase.NewBookmarkUsecase(bookmarkRepository)
+	response := bookmarkUsecase.Unbookmark(context.Background(), 1, "dQw4w9WgXcQ")
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"my-project/domain/dto"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/feature"
+	"my-project/infrastructure/health"
+)
+
+type ICapabilityUsecase interface {
+	GetCapabilities(ctx context.Context) dto.Res
+}
+
+type CapabilityUsecase struct {
+	features feature.IFeature
+	dbHealth health.IHealth
+}
+
+func NewCapabilityUsecase(features feature.IFeature, dbHealth health.IHealth) ICapabilityUsecase {
+	return &CapabilityUsecase{features: features, dbHealth: dbHealth}
+}
+
+func (capabilityUsecase *CapabilityUsecase) GetCapabilities(ctx context.Context) dto.Res {
+	var res dto.Res
+
+	healthy := capabilityUsecase.dbHealth.Healthy()
+	capabilities := dto.Capabilities{
+		Database:        healthy,
+		ReadOnly:        !healthy,
+		Features:        capabilityUsecase.features.All(),
+		CacheTTLSeconds: make(map[string]int64),
+	}
+	for entity, ttl := range cache.TTLs() {
+		capabilities.CacheTTLSeconds[entity] = int64(ttl.Seconds())
+	}
+	if capabilityUsecase.features.Enabled(feature.Captcha) {
+		capabilities.CaptchaSiteKey = configuration.C.Captcha.SiteKey
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = capabilities
+	return res
+}
@@ -0,0 +1,45 @@
+package usecase_test
+
+import (
+	"context"
+	"my-project/domain/dto"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/feature"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubHealth bool
+
+func (h stubHealth) Healthy() bool {
+	return bool(h)
+}
+
+func TestCapabilityUsecase_GetCapabilities(t *testing.T) {
+	features := feature.NewRegistry()
+	features.Set(feature.Cache, true)
+	features.Set(feature.PubSub, false)
+
+	capabilityUsecase := usecase.NewCapabilityUsecase(features, stubHealth(false))
+	response := capabilityUsecase.GetCapabilities(context.Background())
+
+	capabilities := response.Data.(dto.Capabilities)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.False(t, capabilities.Database)
+	assert.True(t, capabilities.ReadOnly)
+	assert.Equal(t, map[string]bool{feature.Cache: true, feature.PubSub: false}, capabilities.Features)
+}
+
+func TestCapabilityUsecase_GetCapabilitiesCacheTTLs(t *testing.T) {
+	configuration.C.Cache.TTLSeconds = map[string]int{cache.EntityUser: 120}
+	defer func() { configuration.C.Cache.TTLSeconds = nil }()
+
+	capabilityUsecase := usecase.NewCapabilityUsecase(feature.NewRegistry(), stubHealth(true))
+	response := capabilityUsecase.GetCapabilities(context.Background())
+
+	capabilities := response.Data.(dto.Capabilities)
+	assert.Equal(t, map[string]int64{cache.EntityUser: 120, cache.EntityRevokedSession: 600, cache.EntityActiveSession: 30, cache.EntityTest: 30}, capabilities.CacheTTLSeconds)
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"my-project/domain/dto"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+	"my-project/infrastructure/utils"
+	"sync"
+	"time"
+)
+
+const exportTimeout = 5 * time.Minute
+
+type IExportUsecase interface {
+	StartExport(ctx context.Context, userId int64) dto.Res
+	GetExportStatus(ctx context.Context, userId int64) dto.Res
+	GetExport(ctx context.Context, userId int64) (dto.AccountExport, bool)
+}
+
+type exportJob struct {
+	status dto.ExportStatus
+	result dto.AccountExport
+}
+
+// ExportUsecase builds account exports in the background and keeps the latest
+// job per user in memory until the next export is requested.
+type ExportUsecase struct {
+	userRepository        repository.IUser
+	searchRepository      repository.ISearch
+	bookmarkRepository    repository.IBookmark
+	noteRepository        repository.INote
+	sessionRepository     repository.ISession
+	accessTokenRepository repository.IAccessToken
+	identityRepository    repository.IIdentity
+
+	mu   sync.Mutex
+	jobs map[int64]*exportJob
+}
+
+func NewExportUsecase(userRepository repository.IUser, searchRepository repository.ISearch, bookmarkRepository repository.IBookmark, noteRepository repository.INote, sessionRepository repository.ISession, accessTokenRepository repository.IAccessToken, identityRepository repository.IIdentity) IExportUsecase {
+	return &ExportUsecase{
+		userRepository:        userRepository,
+		searchRepository:      searchRepository,
+		bookmarkRepository:    bookmarkRepository,
+		noteRepository:        noteRepository,
+		sessionRepository:     sessionRepository,
+		accessTokenRepository: accessTokenRepository,
+		identityRepository:    identityRepository,
+		jobs:                  make(map[int64]*exportJob),
+	}
+}
+
+func (exportUsecase *ExportUsecase) StartExport(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	exportUsecase.mu.Lock()
+	job, ok := exportUsecase.jobs[userId]
+	if ok && (job.status.Status == dto.ExportStatusPending || job.status.Status == dto.ExportStatusRunning) {
+		status := job.status
+		exportUsecase.mu.Unlock()
+		res.ResponseCode = "200"
+		res.ResponseMessage = "Export already in progress"
+		res.Data = status
+		return res
+	}
+	job = &exportJob{status: dto.ExportStatus{
+		Status:    dto.ExportStatusPending,
+		StartedAt: utils.GetCurrentTime(),
+	}}
+	exportUsecase.jobs[userId] = job
+	status := job.status
+	exportUsecase.mu.Unlock()
+
+	// The request context ends with the response, so the export runs on its own.
+	go exportUsecase.run(userId, job)
+
+	res.ResponseCode = "202"
+	res.ResponseMessage = "Export started"
+	res.Data = status
+	return res
+}
+
+func (exportUsecase *ExportUsecase) GetExportStatus(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	exportUsecase.mu.Lock()
+	job, ok := exportUsecase.jobs[userId]
+	var status dto.ExportStatus
+	if ok {
+		status = job.status
+	}
+	exportUsecase.mu.Unlock()
+
+	if !ok {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "No export requested."
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = status
+	return res
+}
+
+// GetExport returns the finished export, or false while none is available.
+func (exportUsecase *ExportUsecase) GetExport(ctx context.Context, userId int64) (dto.AccountExport, bool) {
+	exportUsecase.mu.Lock()
+	defer exportUsecase.mu.Unlock()
+
+	job, ok := exportUsecase.jobs[userId]
+	if !ok || job.status.Status != dto.ExportStatusCompleted {
+		return dto.AccountExport{}, false
+	}
+	return job.result, true
+}
+
+func (exportUsecase *ExportUsecase) run(userId int64, job *exportJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	result := dto.AccountExport{ExportedAt: utils.GetCurrentTime()}
+	steps := []func() error{
+		func() error {
+			user, err := exportUsecase.userRepository.GetById(ctx, int(userId))
+			if err != nil {
+				return err
+			}
+			result.Profile = dto.ExportProfile{
+				ID:        user.ID,
+				Name:      user.Name,
+				UserName:  user.UserName,
+				Email:     user.Email,
+				CreatedAt: user.CreatedAt,
+				UpdatedAt: user.UpdatedAt,
+			}
+			return nil
+		},
+		func() (err error) {
+			result.SearchHistory, err = exportUsecase.searchRepository.GetHistoryByUserId(ctx, userId, 0)
+			return err
+		},
+		func() (err error) {
+			result.SavedSearches, err = exportUsecase.searchRepository.GetSavedSearchesByUserId(ctx, userId)
+			return err
+		},
+		func() (err error) {
+			result.Bookmarks, err = exportUsecase.bookmarkRepository.GetBookmarksByUserId(ctx, userId)
+			return err
+		},
+		func() (err error) {
+			result.Notes, err = exportUsecase.noteRepository.GetNotesByUserId(ctx, userId)
+			return err
+		},
+		func() (err error) {
+			result.Sessions, err = exportUsecase.sessionRepository.GetSessionsByUserId(ctx, userId)
+			return err
+		},
+		func() (err error) {
+			result.AccessTokens, err = exportUsecase.accessTokenRepository.GetTokensByUserId(ctx, userId)
+			return err
+		},
+		func() (err error) {
+			result.Identities, err = exportUsecase.identityRepository.GetIdentitiesByUserId(ctx, userId)
+			return err
+		},
+	}
+
+	exportUsecase.update(job, func(status *dto.ExportStatus) {
+		status.Status = dto.ExportStatusRunning
+	})
+	for i, step := range steps {
+		if err := step(); err != nil {
+			logger.GetLogger().WithField("error", err).WithField("user_id", userId).Error("Error while export account data")
+			exportUsecase.update(job, func(status *dto.ExportStatus) {
+				status.Status = dto.ExportStatusFailed
+				status.Error = "Export failed, please try again."
+			})
+			return
+		}
+		progress := (i + 1) * 100 / len(steps)
+		exportUsecase.update(job, func(status *dto.ExportStatus) {
+			status.Progress = progress
+		})
+	}
+
+	exportUsecase.mu.Lock()
+	defer exportUsecase.mu.Unlock()
+	completedAt := utils.GetCurrentTime()
+	job.result = result
+	job.status.Status = dto.ExportStatusCompleted
+	job.status.CompletedAt = &completedAt
+}
+
+func (exportUsecase *ExportUsecase) update(job *exportJob, fn func(status *dto.ExportStatus)) {
+	exportUsecase.mu.Lock()
+	defer exportUsecase.mu.Unlock()
+	fn(&job.status)
+}
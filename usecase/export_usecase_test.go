@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func exportStatus(exportUsecase usecase.IExportUsecase) func() bool {
+	return func() bool {
+		res := exportUsecase.GetExportStatus(context.Background(), 1)
+		status, ok := res.Data.(dto.ExportStatus)
+		return ok && (status.Status == dto.ExportStatusCompleted || status.Status == dto.ExportStatusFailed)
+	}
+}
+
+func TestExportUsecase_ExportSuccess(t *testing.T) {
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetById", mock.Anything, 1).Return(model.User{ID: 1, Name: "Lambok Tulus Simamora", UserName: "lamboktulus1379", Password: "secret", Email: "tulus@example.com"}, nil).Once()
+	searchRepository := &repomocks.ISearch{}
+	searchRepository.On("GetHistoryByUserId", mock.Anything, int64(1), 0).Return([]model.SearchHistory{{ID: 1, UserID: 1, Query: "golang"}}, nil).Once()
+	searchRepository.On("GetSavedSearchesByUserId", mock.Anything, int64(1)).Return([]model.SavedSearch{}, nil).Once()
+	bookmarkRepository := &repomocks.IBookmark{}
+	bookmarkRepository.On("GetBookmarksByUserId", mock.Anything, int64(1)).Return([]model.VideoBookmark{{ID: 1, UserID: 1, VideoID: "dQw4w9WgXcQ"}}, nil).Once()
+	noteRepository := &repomocks.INote{}
+	noteRepository.On("GetNotesByUserId", mock.Anything, int64(1)).Return([]model.VideoNote{}, nil).Once()
+	sessionRepository := &repomocks.ISession{}
+	sessionRepository.On("GetSessionsByUserId", mock.Anything, int64(1)).Return([]model.UserSession{{ID: 7, UserID: 1, UserAgent: "Firefox"}}, nil).Once()
+	accessTokenRepository := &repomocks.IAccessToken{}
+	accessTokenRepository.On("GetTokensByUserId", mock.Anything, int64(1)).Return([]model.AccessToken{{ID: 3, UserID: 1, Name: "cli", Prefix: "pat_abcd", TokenHash: "hash"}}, nil).Once()
+
+	identityRepository := &repomocks.IIdentity{}
+	identityRepository.On("GetIdentitiesByUserId", mock.Anything, int64(1)).Return([]model.UserIdentity{}, nil).Once()
+
+	exportUsecase := usecase.NewExportUsecase(userRepository, searchRepository, bookmarkRepository, noteRepository, sessionRepository, accessTokenRepository, identityRepository)
+	response := exportUsecase.StartExport(context.Background(), 1)
+	assert.Equal(t, "202", response.ResponseCode)
+
+	assert.Eventually(t, exportStatus(exportUsecase), time.Second, 10*time.Millisecond)
+
+	export, ok := exportUsecase.GetExport(context.Background(), 1)
+	assert.True(t, ok)
+	assert.Equal(t, "lamboktulus1379", export.Profile.UserName)
+	assert.Equal(t, "tulus@example.com", export.Profile.Email)
+	assert.Len(t, export.SearchHistory, 1)
+	assert.Len(t, export.Bookmarks, 1)
+	assert.Len(t, export.Sessions, 1)
+	assert.Len(t, export.AccessTokens, 1)
+}
+
+func TestExportUsecase_ExportFailed(t *testing.T) {
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetById", mock.Anything, 1).Return(model.User{}, sql.ErrNoRows).Once()
+
+	exportUsecase := usecase.NewExportUsecase(userRepository, &repomocks.ISearch{}, &repomocks.IBookmark{}, &repomocks.INote{}, &repomocks.ISession{}, &repomocks.IAccessToken{}, &repomocks.IIdentity{})
+	exportUsecase.StartExport(context.Background(), 1)
+
+	assert.Eventually(t, exportStatus(exportUsecase), time.Second, 10*time.Millisecond)
+
+	_, ok := exportUsecase.GetExport(context.Background(), 1)
+	assert.False(t, ok)
+	response := exportUsecase.GetExportStatus(context.Background(), 1)
+	assert.Equal(t, dto.ExportStatusFailed, response.Data.(dto.ExportStatus).Status)
+}
+
+func TestExportUsecase_StatusNotRequested(t *testing.T) {
+	exportUsecase := usecase.NewExportUsecase(&repomocks.IUser{}, &repomocks.ISearch{}, &repomocks.IBookmark{}, &repomocks.INote{}, &repomocks.ISession{}, &repomocks.IAccessToken{}, &repomocks.IIdentity{})
+
+	response := exportUsecase.GetExportStatus(context.Background(), 1)
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"my-project/domain/dto"
+	"sort"
+	"strings"
+)
+
+// loadTestPaths are the read endpoints a scenario exercises. They only read
+// the caller's own data, so replaying them does not change any state.
+var loadTestPaths = []string{
+	"/api/capabilities",
+	"/api/searches/history",
+	"/api/searches/saved",
+	"/api/bookmarks",
+	"/api/videos/dQw4w9WgXcQ/notes",
+	"/api/me/sessions",
+}
+
+type ILoadTestUsecase interface {
+	GetScenario(ctx context.Context, baseURL string, authorization string) []dto.LoadTestRequest
+	GetVegetaTargets(ctx context.Context, baseURL string, authorization string) string
+}
+
+type LoadTestUsecase struct{}
+
+func NewLoadTestUsecase() ILoadTestUsecase {
+	return &LoadTestUsecase{}
+}
+
+func (loadTestUsecase *LoadTestUsecase) GetScenario(ctx context.Context, baseURL string, authorization string) []dto.LoadTestRequest {
+	baseURL = strings.TrimRight(baseURL, "/")
+	requests := make([]dto.LoadTestRequest, 0, len(loadTestPaths))
+	for _, path := range loadTestPaths {
+		requests = append(requests, dto.LoadTestRequest{
+			Method: "GET",
+			URL:    baseURL + path,
+			Params: dto.LoadTestParams{Headers: map[string]string{"Authorization": authorization}},
+		})
+	}
+	return requests
+}
+
+// GetVegetaTargets renders the scenario in vegeta's HTTP target format:
+// one request line followed by its headers, separated by blank lines.
+func (loadTestUsecase *LoadTestUsecase) GetVegetaTargets(ctx context.Context, baseURL string, authorization string) string {
+	var b strings.Builder
+	for _, request := range loadTestUsecase.GetScenario(ctx, baseURL, authorization) {
+		fmt.Fprintf(&b, "%s %s\n", request.Method, request.URL)
+		names := make([]string, 0, len(request.Params.Headers))
+		for name := range request.Params.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&b, "%s: %s\n", name, request.Params.Headers[name])
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
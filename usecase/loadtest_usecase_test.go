@@ -0,0 +1,19 @@
+package usecase_test
+
+import (
+	"context"
+	"my-project/usecase"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTestUsecase_GetVegetaTargets(t *testing.T) {
+	loadTestUsecase := usecase.NewLoadTestUsecase()
+
+	targets := loadTestUsecase.GetVegetaTargets(context.Background(), "http://localhost:10001/", "Bearer token")
+
+	assert.True(t, strings.HasPrefix(targets, "GET http://localhost:10001/api/capabilities\nAuthorization: Bearer token\n\n"))
+	assert.Equal(t, len(loadTestUsecase.GetScenario(context.Background(), "", "")), strings.Count(targets, "GET "))
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type INoteUsecase interface {
+	CreateNote(ctx context.Context, userId int64, videoId string, req model.ReqVideoNote) dto.Res
+	GetNotes(ctx context.Context, userId int64, videoId string) dto.Res
+	UpdateNote(ctx context.Context, userId int64, videoId string, id int64, req model.ReqVideoNote) dto.Res
+	DeleteNote(ctx context.Context, userId int64, videoId string, id int64) dto.Res
+}
+
+type NoteUsecase struct {
+	noteRepository repository.INote
+}
+
+func NewNoteUsecase(noteRepository repository.INote) INoteUsecase {
+	return &NoteUsecase{noteRepository: noteRepository}
+}
+
+func (noteUsecase *NoteUsecase) CreateNote(ctx context.Context, userId int64, videoId string, req model.ReqVideoNote) dto.Res {
+	var res dto.Res
+
+	note, err := noteUsecase.noteRepository.CreateNote(ctx, model.VideoNote{
+		VideoID: videoId,
+		UserID:  userId,
+		Body:    req.Body,
+	})
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("video_id", videoId).Error("Error while create note")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = note
+	return res
+}
+
+func (noteUsecase *NoteUsecase) GetNotes(ctx context.Context, userId int64, videoId string) dto.Res {
+	var res dto.Res
+
+	notes, err := noteUsecase.noteRepository.GetNotesByVideoId(ctx, userId, videoId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("video_id", videoId).Error("Error while get notes")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = notes
+	return res
+}
+
+func (noteUsecase *NoteUsecase) UpdateNote(ctx context.Context, userId int64, videoId string, id int64, req model.ReqVideoNote) dto.Res {
+	var res dto.Res
+
+	note, err := noteUsecase.noteRepository.UpdateNote(ctx, model.VideoNote{
+		ID:      id,
+		VideoID: videoId,
+		UserID:  userId,
+		Body:    req.Body,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Note not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("video_id", videoId).Error("Error while update note")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = note
+	return res
+}
+
+func (noteUsecase *NoteUsecase) DeleteNote(ctx context.Context, userId int64, videoId string, id int64) dto.Res {
+	var res dto.Res
+
+	err := noteUsecase.noteRepository.DeleteNote(ctx, userId, videoId, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Note not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("video_id", videoId).Error("Error while delete note")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
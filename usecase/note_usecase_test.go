@@ -0,0 +1,39 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNoteUsecase_CreateNoteSuccess(t *testing.T) {
+	noteRepository := &repomocks.INote{}
+	noteRepository.On("CreateNote", context.Background(), mock.AnythingOfType("model.VideoNote")).Return(model.VideoNote{
+		ID:      1,
+		VideoID: "dQw4w9WgXcQ",
+		UserID:  1,
+		Body:    "Add chapters",
+	}, nil).Once()
+
+	noteUsecase := usecase.NewNoteUsecase(noteRepository)
+	response := noteUsecase.CreateNote(context.Background(), 1, "dQw4w9WgXcQ", model.ReqVideoNote{Body: "Add chapters"})
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, int64(1), response.Data.(model.VideoNote).ID)
+}
+
+func TestNoteUsecase_UpdateNoteNotFound(t *testing.T) {
+	noteRepository := &repomocks.INote{}
+	noteRepository.On("UpdateNote", context.Background(), mock.AnythingOfType("model.VideoNote")).Return(model.VideoNote{}, sql.ErrNoRows).Once()
+
+	noteUsecase := usecase.NewNoteUsecase(noteRepository)
+	response := noteUsecase.UpdateNote(context.Background(), 1, "dQw4w9WgXcQ", 9, model.ReqVideoNote{Body: "Add chapters"})
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
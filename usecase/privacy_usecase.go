@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"my-project/domain/dto"
+	"my-project/domain/repository"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/configuration"
+	"my-project/infrastructure/logger"
+	"my-project/infrastructure/utils"
+	"time"
+)
+
+type IPrivacyUsecase interface {
+	DeleteMyData(ctx context.Context, userId int64) dto.Res
+	CleanupExpired(ctx context.Context)
+}
+
+type PrivacyUsecase struct {
+	privacyRepository repository.IPrivacy
+	searchRepository  repository.ISearch
+	userRepository    repository.IUser
+	userCache         cache.IUserCache
+}
+
+func NewPrivacyUsecase(privacyRepository repository.IPrivacy, searchRepository repository.ISearch, userRepository repository.IUser, userCache cache.IUserCache) IPrivacyUsecase {
+	return &PrivacyUsecase{
+		privacyRepository: privacyRepository,
+		searchRepository:  searchRepository,
+		userRepository:    userRepository,
+		userCache:         userCache,
+	}
+}
+
+func (privacyUsecase *PrivacyUsecase) DeleteMyData(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	user, err := privacyUsecase.userRepository.GetById(ctx, int(userId))
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("user_id", userId).Error("Error while get user")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	err = privacyUsecase.privacyRepository.PurgeUserData(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("user_id", userId).Error("Error while purge user data")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	// Drop the cached user so existing tokens stop authenticating right away.
+	privacyUsecase.userCache.Invalidate(ctx, user.UserName)
+	logger.GetLogger().WithField("user_id", userId).Info("User data purged")
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+// CleanupExpired removes rows older than the configured retention. A
+// retention of zero days keeps rows forever.
+func (privacyUsecase *PrivacyUsecase) CleanupExpired(ctx context.Context) {
+	days := configuration.C.Retention.SearchHistoryDays
+	if days <= 0 {
+		return
+	}
+
+	before := utils.GetCurrentTime().AddDate(0, 0, -days)
+	deleted, err := privacyUsecase.searchRepository.DeleteHistoryBefore(ctx, before)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while cleanup search history")
+		return
+	}
+	logger.GetLogger().WithField("deleted", deleted).WithField("before", before.Format(time.RFC3339)).Info("Search history cleaned up")
+}
@@ -0,0 +1,65 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/configuration"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPrivacyUsecase_DeleteMyDataSuccess(t *testing.T) {
+	privacyRepository := &repomocks.IPrivacy{}
+	privacyRepository.On("PurgeUserData", context.Background(), int64(1)).Return(nil).Once()
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetById", context.Background(), 1).Return(model.User{ID: 1, UserName: "lamboktulus1379"}, nil).Once()
+	userCache := cache.NewUserCache(nil)
+	userCache.Set(context.Background(), model.User{ID: 1, UserName: "lamboktulus1379"})
+
+	privacyUsecase := usecase.NewPrivacyUsecase(privacyRepository, &repomocks.ISearch{}, userRepository, userCache)
+	response := privacyUsecase.DeleteMyData(context.Background(), 1)
+
+	assert.Equal(t, "200", response.ResponseCode)
+	_, cached := userCache.Get(context.Background(), "lamboktulus1379")
+	assert.False(t, cached)
+}
+
+func TestPrivacyUsecase_DeleteMyDataError(t *testing.T) {
+	privacyRepository := &repomocks.IPrivacy{}
+	privacyRepository.On("PurgeUserData", context.Background(), int64(1)).Return(sql.ErrTxDone).Once()
+	userRepository := &repomocks.IUser{}
+	userRepository.On("GetById", context.Background(), 1).Return(model.User{ID: 1, UserName: "lamboktulus1379"}, nil).Once()
+
+	privacyUsecase := usecase.NewPrivacyUsecase(privacyRepository, &repomocks.ISearch{}, userRepository, cache.NewUserCache(nil))
+	response := privacyUsecase.DeleteMyData(context.Background(), 1)
+
+	assert.Equal(t, "500", response.ResponseCode)
+}
+
+func TestPrivacyUsecase_CleanupExpired(t *testing.T) {
+	configuration.C.Retention.SearchHistoryDays = 30
+	defer func() { configuration.C.Retention.SearchHistoryDays = 0 }()
+
+	searchRepository := repomocks.NewISearch(t)
+	searchRepository.On("DeleteHistoryBefore", context.Background(), mock.AnythingOfType("time.Time")).Return(int64(3), nil).Once()
+
+	privacyUsecase := usecase.NewPrivacyUsecase(&repomocks.IPrivacy{}, searchRepository, &repomocks.IUser{}, cache.NewUserCache(nil))
+	privacyUsecase.CleanupExpired(context.Background())
+}
+
+func TestPrivacyUsecase_CleanupExpiredDisabled(t *testing.T) {
+	configuration.C.Retention.SearchHistoryDays = 0
+
+	searchRepository := repomocks.NewISearch(t)
+
+	privacyUsecase := usecase.NewPrivacyUsecase(&repomocks.IPrivacy{}, searchRepository, &repomocks.IUser{}, cache.NewUserCache(nil))
+	privacyUsecase.CleanupExpired(context.Background())
+
+	searchRepository.AssertNotCalled(t, "DeleteHistoryBefore", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type IRegistrationUsecase interface {
+	GetPendingRegistrations(ctx context.Context) dto.Res
+	ApproveRegistration(ctx context.Context, id int64) dto.Res
+	RejectRegistration(ctx context.Context, id int64) dto.Res
+}
+
+type RegistrationUsecase struct {
+	userRepository repository.IUser
+}
+
+func NewRegistrationUsecase(userRepository repository.IUser) IRegistrationUsecase {
+	return &RegistrationUsecase{userRepository: userRepository}
+}
+
+func (registrationUsecase *RegistrationUsecase) GetPendingRegistrations(ctx context.Context) dto.Res {
+	var res dto.Res
+
+	users, err := registrationUsecase.userRepository.GetByStatus(ctx, model.UserStatusPending)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get pending registrations")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = users
+	return res
+}
+
+func (registrationUsecase *RegistrationUsecase) ApproveRegistration(ctx context.Context, id int64) dto.Res {
+	return registrationUsecase.decide(ctx, id, model.UserStatusActive)
+}
+
+func (registrationUsecase *RegistrationUsecase) RejectRegistration(ctx context.Context, id int64) dto.Res {
+	return registrationUsecase.decide(ctx, id, model.UserStatusRejected)
+}
+
+func (registrationUsecase *RegistrationUsecase) decide(ctx context.Context, id int64, status string) dto.Res {
+	var res dto.Res
+
+	err := registrationUsecase.userRepository.UpdateStatus(ctx, id, model.UserStatusPending, status)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Pending registration not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("user_id", id).Error("Error while update registration")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
@@ -0,0 +1,32 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistrationUsecase_ApproveRegistration(t *testing.T) {
+	userRepository := &repomocks.IUser{}
+	userRepository.On("UpdateStatus", context.Background(), int64(3), model.UserStatusPending, model.UserStatusActive).Return(nil).Once()
+
+	registrationUsecase := usecase.NewRegistrationUsecase(userRepository)
+	response := registrationUsecase.ApproveRegistration(context.Background(), 3)
+
+	assert.Equal(t, "200", response.ResponseCode)
+}
+
+func TestRegistrationUsecase_RejectRegistrationNotPending(t *testing.T) {
+	userRepository := &repomocks.IUser{}
+	userRepository.On("UpdateStatus", context.Background(), int64(3), model.UserStatusPending, model.UserStatusRejected).Return(sql.ErrNoRows).Once()
+
+	registrationUsecase := usecase.NewRegistrationUsecase(userRepository)
+	response := registrationUsecase.RejectRegistration(context.Background(), 3)
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
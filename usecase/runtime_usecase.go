@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"my-project/domain/dto"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+type IRuntimeUsecase interface {
+	GetRuntime(ctx context.Context) dto.Res
+}
+
+type RuntimeUsecase struct {
+	startedAt time.Time
+}
+
+func NewRuntimeUsecase(startedAt time.Time) IRuntimeUsecase {
+	return &RuntimeUsecase{startedAt: startedAt}
+}
+
+func (runtimeUsecase *RuntimeUsecase) GetRuntime(ctx context.Context) dto.Res {
+	var res dto.Res
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	stats := dto.RuntimeStats{
+		StartedAt:  runtimeUsecase.startedAt,
+		Uptime:     time.Since(runtimeUsecase.startedAt).Round(time.Second).String(),
+		Goroutines: runtime.NumGoroutine(),
+		CPUs:       runtime.NumCPU(),
+		Memory: dto.MemStats{
+			HeapAllocBytes: mem.HeapAlloc,
+			HeapInuseBytes: mem.HeapInuse,
+			HeapObjects:    mem.HeapObjects,
+			SysBytes:       mem.Sys,
+		},
+		GC: dto.GCStats{
+			NumGC:       mem.NumGC,
+			PauseTotal:  time.Duration(mem.PauseTotalNs).String(),
+			CPUFraction: mem.GCCPUFraction,
+			NextGCBytes: mem.NextGC,
+		},
+		Build: dto.BuildStats{GoVersion: runtime.Version()},
+	}
+	if mem.NumGC > 0 {
+		lastGC := time.Unix(0, int64(mem.LastGC))
+		stats.GC.LastGC = &lastGC
+		stats.GC.LastPause = time.Duration(mem.PauseNs[(mem.NumGC+255)%256]).String()
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		stats.Build.Path = info.Main.Path
+		stats.Build.Version = info.Main.Version
+		stats.Build.Settings = make(map[string]string)
+		for _, setting := range info.Settings {
+			stats.Build.Settings[setting.Key] = setting.Value
+		}
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = stats
+	return res
+}
@@ -0,0 +1,26 @@
+package usecase_test
+
+import (
+	"context"
+	"my-project/domain/dto"
+	"my-project/usecase"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeUsecase_GetRuntime(t *testing.T) {
+	runtime.GC()
+	runtimeUsecase := usecase.NewRuntimeUsecase(time.Now().Add(-time.Minute))
+
+	response := runtimeUsecase.GetRuntime(context.Background())
+
+	stats := response.Data.(dto.RuntimeStats)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, "1m0s", stats.Uptime)
+	assert.Positive(t, stats.Goroutines)
+	assert.NotNil(t, stats.GC.LastGC)
+	assert.Equal(t, runtime.Version(), stats.Build.GoVersion)
+}
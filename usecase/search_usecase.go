@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+const searchHistoryLimit = 50
+
+type ISearchUsecase interface {
+	RecordSearch(ctx context.Context, userId int64, req model.ReqSearchHistory) dto.Res
+	GetHistory(ctx context.Context, userId int64) dto.Res
+	DeleteHistory(ctx context.Context, userId int64, id int64) dto.Res
+	ClearHistory(ctx context.Context, userId int64) dto.Res
+	SaveSearch(ctx context.Context, userId int64, req model.ReqSavedSearch) dto.Res
+	GetSavedSearches(ctx context.Context, userId int64) dto.Res
+	DeleteSavedSearch(ctx context.Context, userId int64, id int64) dto.Res
+}
+
+type SearchUsecase struct {
+	searchRepository repository.ISearch
+}
+
+func NewSearchUsecase(searchRepository repository.ISearch) ISearchUsecase {
+	return &SearchUsecase{searchRepository: searchRepository}
+}
+
+func (searchUsecase *SearchUsecase) RecordSearch(ctx context.Context, userId int64, req model.ReqSearchHistory) dto.Res {
+	var res dto.Res
+
+	err := searchUsecase.searchRepository.CreateHistory(ctx, model.SearchHistory{UserID: userId, Query: req.Query})
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while create search history")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+func (searchUsecase *SearchUsecase) GetHistory(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	histories, err := searchUsecase.searchRepository.GetHistoryByUserId(ctx, userId, searchHistoryLimit)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get search history")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = histories
+	return res
+}
+
+func (searchUsecase *SearchUsecase) DeleteHistory(ctx context.Context, userId int64, id int64) dto.Res {
+	var res dto.Res
+
+	err := searchUsecase.searchRepository.DeleteHistory(ctx, userId, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Search history not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while delete search history")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+func (searchUsecase *SearchUsecase) ClearHistory(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	err := searchUsecase.searchRepository.DeleteAllHistory(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while clear search history")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+func (searchUsecase *SearchUsecase) SaveSearch(ctx context.Context, userId int64, req model.ReqSavedSearch) dto.Res {
+	var res dto.Res
+
+	savedSearch := model.SavedSearch{
+		UserID: userId,
+		Name:   req.Name,
+		Query:  req.Query,
+	}
+	id, err := searchUsecase.searchRepository.CreateSavedSearch(ctx, savedSearch)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "409"
+		res.ResponseMessage = "Saved search already exists."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while create saved search")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	savedSearch.ID = id
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = savedSearch
+	return res
+}
+
+func (searchUsecase *SearchUsecase) GetSavedSearches(ctx context.Context, userId int64) dto.Res {
+	var res dto.Res
+
+	savedSearches, err := searchUsecase.searchRepository.GetSavedSearchesByUserId(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get saved searches")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = savedSearches
+	return res
+}
+
+func (searchUsecase *SearchUsecase) DeleteSavedSearch(ctx context.Context, userId int64, id int64) dto.Res {
+	var res dto.Res
+
+	err := searchUsecase.searchRepository.DeleteSavedSearch(ctx, userId, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Saved search not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while delete saved search")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
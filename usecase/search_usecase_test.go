@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchUsecase_GetHistorySuccess(t *testing.T) {
+	searchRepository := &repomocks.ISearch{}
+	searchRepository.On("GetHistoryByUserId", context.Background(), int64(1), mock.AnythingOfType("int")).Return([]model.SearchHistory{
+		{ID: 1, UserID: 1, Query: "golang"},
+	}, nil).Once()
+
+	searchUsecase := usecase.NewSearchUsecase(searchRepository)
+	response := searchUsecase.GetHistory(context.Background(), 1)
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Len(t, response.Data, 1)
+}
+
+func TestSearchUsecase_DeleteHistoryNotFound(t *testing.T) {
+	searchRepository := &repomocks.ISearch{}
+	searchRepository.On("DeleteHistory", context.Background(), int64(1), int64(2)).Return(sql.ErrNoRows).Once()
+
+	searchUsecase := usecase.NewSearchUsecase(searchRepository)
+	response := searchUsecase.DeleteHistory(context.Background(), 1, 2)
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
+
+func TestSearchUsecase_SaveSearchSuccess(t *testing.T) {
+	searchRepository := &repomocks.ISearch{}
+	searchRepository.On("CreateSavedSearch", context.Background(), mock.AnythingOfType("model.SavedSearch")).Return(int64(7), nil).Once()
+
+	searchUsecase := usecase.NewSearchUsecase(searchRepository)
+	response := searchUsecase.SaveSearch(context.Background(), 1, model.ReqSavedSearch{
+		Name:  "Tutorials",
+		Query: "golang tutorial",
+	})
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, int64(7), response.Data.(model.SavedSearch).ID)
+}
+
+func TestSearchUsecase_SaveSearchDuplicate(t *testing.T) {
+	searchRepository := &repomocks.ISearch{}
+	searchRepository.On("CreateSavedSearch", context.Background(), mock.AnythingOfType("model.SavedSearch")).Return(int64(0), sql.ErrNoRows).Once()
+
+	searchUsecase := usecase.NewSearchUsecase(searchRepository)
+	response := searchUsecase.SaveSearch(context.Background(), 1, model.ReqSavedSearch{
+		Name:  "Tutorials",
+		Query: "golang tutorial",
+	})
+
+	assert.Equal(t, "409", response.ResponseCode)
+}
+
+func TestSearchUsecase_SaveSearchError(t *testing.T) {
+	searchRepository := &repomocks.ISearch{}
+	searchRepository.On("CreateSavedSearch", context.Background(), mock.AnythingOfType("model.SavedSearch")).Return(int64(0), sql.ErrConnDone).Once()
+
+	searchUsecase := usecase.NewSearchUsecase(searchRepository)
+	response := searchUsecase.SaveSearch(context.Background(), 1, model.ReqSavedSearch{
+		Name:  "Tutorials",
+		Query: "golang tutorial",
+	})
+
+	assert.Equal(t, "500", response.ResponseCode)
+}
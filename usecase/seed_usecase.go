@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"crypto/md5"
+	"database/sql"
+	"errors"
+	"fmt"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/logger"
+)
+
+type ISeedUsecase interface {
+	Seed(ctx context.Context, fixtures dto.Fixtures) dto.Res
+}
+
+type SeedUsecase struct {
+	userRepository repository.IUser
+	seedRepository repository.ISeed
+}
+
+func NewSeedUsecase(userRepository repository.IUser, seedRepository repository.ISeed) ISeedUsecase {
+	return &SeedUsecase{
+		userRepository: userRepository,
+		seedRepository: seedRepository,
+	}
+}
+
+// Seed loads fixtures user by user. Users that already exist are skipped
+// together with their data, so seeding the same fixtures twice is harmless.
+func (seedUsecase *SeedUsecase) Seed(ctx context.Context, fixtures dto.Fixtures) dto.Res {
+	var res dto.Res
+	result := dto.SeedResult{UsersSkipped: []string{}}
+
+	for _, fixture := range fixtures.Users {
+		created, err := seedUsecase.seedUser(ctx, fixture)
+		if err != nil {
+			logger.GetLogger().WithField("error", err).WithField("user_name", fixture.UserName).Error("Error while seed user")
+			res.ResponseCode = "500"
+			res.ResponseMessage = fmt.Sprintf("Seeding stopped at user %s", fixture.UserName)
+			res.Data = result
+			return res
+		}
+		if created {
+			result.UsersCreated++
+		} else {
+			result.UsersSkipped = append(result.UsersSkipped, fixture.UserName)
+		}
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = result
+	return res
+}
+
+func (seedUsecase *SeedUsecase) seedUser(ctx context.Context, fixture dto.FixtureUser) (bool, error) {
+	_, err := seedUsecase.userRepository.GetByUserName(ctx, fixture.UserName)
+	if err == nil {
+		return false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	savedSearches := make([]model.SavedSearch, 0, len(fixture.SavedSearches))
+	for _, savedSearch := range fixture.SavedSearches {
+		savedSearches = append(savedSearches, model.SavedSearch{Name: savedSearch.Name, Query: savedSearch.Query})
+	}
+	bookmarks := make([]model.VideoBookmark, 0, len(fixture.Bookmarks))
+	for _, videoId := range fixture.Bookmarks {
+		bookmarks = append(bookmarks, model.VideoBookmark{VideoID: videoId})
+	}
+	notes := make([]model.VideoNote, 0, len(fixture.Notes))
+	for _, note := range fixture.Notes {
+		notes = append(notes, model.VideoNote{VideoID: note.VideoID, Body: note.Body})
+	}
+
+	_, err = seedUsecase.seedRepository.CreateUserWithData(ctx, model.User{
+		Name:     fixture.Name,
+		UserName: fixture.UserName,
+		Password: fmt.Sprintf("%x", md5.Sum([]byte(fixture.Password))),
+	}, savedSearches, bookmarks, notes)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
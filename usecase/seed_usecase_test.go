@@ -0,0 +1,41 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSeedUsecase_Seed(t *testing.T) {
+	userRepository := repomocks.NewIUser(t)
+	userRepository.On("GetByUserName", context.Background(), "viewer").Return(model.User{ID: 2, UserName: "viewer"}, nil).Once()
+	userRepository.On("GetByUserName", context.Background(), "demo").Return(model.User{}, sql.ErrNoRows).Once()
+	seedRepository := repomocks.NewISeed(t)
+	seedRepository.On("CreateUserWithData", context.Background(), mock.AnythingOfType("model.User"),
+		[]model.SavedSearch{{Name: "Go tutorials", Query: "golang tutorial"}},
+		[]model.VideoBookmark{{VideoID: "dQw4w9WgXcQ"}},
+		[]model.VideoNote{{VideoID: "dQw4w9WgXcQ", Body: "Add chapters."}}).Return(int64(1), nil).Once()
+
+	seedUsecase := usecase.NewSeedUsecase(userRepository, seedRepository)
+	response := seedUsecase.Seed(context.Background(), dto.Fixtures{Users: []dto.FixtureUser{
+		{UserName: "viewer", Bookmarks: []string{"YS4e4q9oBaU"}},
+		{
+			Name:          "Demo Editor",
+			UserName:      "demo",
+			Password:      "Demo_123",
+			SavedSearches: []model.ReqSavedSearch{{Name: "Go tutorials", Query: "golang tutorial"}},
+			Bookmarks:     []string{"dQw4w9WgXcQ"},
+			Notes:         []dto.FixtureNote{{VideoID: "dQw4w9WgXcQ", Body: "Add chapters."}},
+		},
+	}})
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, dto.SeedResult{UsersCreated: 1, UsersSkipped: []string{"viewer"}}, response.Data)
+}
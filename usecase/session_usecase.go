@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+type ISessionUsecase interface {
+	GetSessions(ctx context.Context, userId int64, currentSessionId int64) dto.Res
+	RevokeSession(ctx context.Context, userId int64, id int64) dto.Res
+	CleanupExpired(ctx context.Context)
+}
+
+type SessionUsecase struct {
+	sessionRepository repository.ISession
+	sessionCache      cache.ISessionCache
+}
+
+func NewSessionUsecase(sessionRepository repository.ISession, sessionCache cache.ISessionCache) ISessionUsecase {
+	return &SessionUsecase{sessionRepository: sessionRepository, sessionCache: sessionCache}
+}
+
+func (sessionUsecase *SessionUsecase) GetSessions(ctx context.Context, userId int64, currentSessionId int64) dto.Res {
+	var res dto.Res
+
+	sessions, err := sessionUsecase.sessionRepository.GetSessionsByUserId(ctx, userId)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get sessions")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentSessionId
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = sessions
+	return res
+}
+
+// RevokeSession marks the session revoked in the database and in the
+// revocation cache, so tokens already issued for it stop working right away.
+func (sessionUsecase *SessionUsecase) RevokeSession(ctx context.Context, userId int64, id int64) dto.Res {
+	var res dto.Res
+
+	err := sessionUsecase.sessionRepository.RevokeSession(ctx, userId, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Session not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while revoke session")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	sessionUsecase.sessionCache.Revoke(ctx, id)
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+// CleanupExpired removes sessions whose token has expired. They can no longer
+// be used, so neither the session list nor the revocation check needs them.
+func (sessionUsecase *SessionUsecase) CleanupExpired(ctx context.Context) {
+	before := time.Now().Add(-model.SessionTokenLifetime)
+	deleted, err := sessionUsecase.sessionRepository.DeleteSessionsBefore(ctx, before)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while cleanup sessions")
+		return
+	}
+	logger.GetLogger().WithField("deleted", deleted).WithField("before", before.Format(time.RFC3339)).Info("Sessions cleaned up")
+}
@@ -0,0 +1,69 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSessionUsecase_GetSessionsMarksCurrent(t *testing.T) {
+	sessionRepository := &repomocks.ISession{}
+	sessionRepository.On("GetSessionsByUserId", context.Background(), int64(1)).Return([]model.UserSession{
+		{ID: 3, UserID: 1, UserAgent: "Firefox"},
+		{ID: 4, UserID: 1, UserAgent: "Safari"},
+	}, nil).Once()
+
+	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, cache.NewSessionCache(nil))
+	response := sessionUsecase.GetSessions(context.Background(), 1, 4)
+
+	sessions := response.Data.([]model.UserSession)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.False(t, sessions[0].Current)
+	assert.True(t, sessions[1].Current)
+}
+
+func TestSessionUsecase_RevokeSessionIsEnforcedImmediately(t *testing.T) {
+	sessionRepository := &repomocks.ISession{}
+	sessionRepository.On("RevokeSession", context.Background(), int64(1), int64(3)).Return(nil).Once()
+	sessionCache := cache.NewSessionCache(nil)
+
+	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, sessionCache)
+	response := sessionUsecase.RevokeSession(context.Background(), 1, 3)
+
+	assert.Equal(t, "200", response.ResponseCode)
+	revoked, err := sessionCache.IsRevoked(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestSessionUsecase_RevokeSessionNotFound(t *testing.T) {
+	sessionRepository := &repomocks.ISession{}
+	sessionRepository.On("RevokeSession", context.Background(), int64(1), int64(3)).Return(sql.ErrNoRows).Once()
+	sessionCache := cache.NewSessionCache(nil)
+
+	sessionUsecase := usecase.NewSessionUsecase(sessionRepository, sessionCache)
+	response := sessionUsecase.RevokeSession(context.Background(), 1, 3)
+
+	assert.Equal(t, "404", response.ResponseCode)
+	revoked, _ := sessionCache.IsRevoked(context.Background(), 3)
+	assert.False(t, revoked)
+}
+
+func TestSessionUsecase_CleanupExpired(t *testing.T) {
+	sessionRepository := &repomocks.ISession{}
+	sessionRepository.On("DeleteSessionsBefore", context.Background(), mock.MatchedBy(func(before time.Time) bool {
+		return time.Since(before) >= model.SessionTokenLifetime
+	})).Return(int64(2), nil).Once()
+
+	usecase.NewSessionUsecase(sessionRepository, cache.NewSessionCache(nil)).CleanupExpired(context.Background())
+
+	sessionRepository.AssertExpectations(t)
+}
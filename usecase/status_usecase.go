@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/domain/repository"
+	"my-project/infrastructure/feature"
+	"my-project/infrastructure/health"
+	"my-project/infrastructure/logger"
+	"time"
+)
+
+// incidentWindow is how far back resolved incidents stay on the status page.
+const incidentWindow = 7 * 24 * time.Hour
+
+// statusComponents are the optional services listed on the status page. A
+// disabled one degrades the service without taking it down.
+var statusComponents = []string{feature.Cache, feature.PubSub, feature.ServiceBus, feature.TulusTech}
+
+type IStatusUsecase interface {
+	GetStatus(ctx context.Context) dto.Res
+	CreateIncident(ctx context.Context, req model.ReqIncident) dto.Res
+	ResolveIncident(ctx context.Context, id int64) dto.Res
+}
+
+type StatusUsecase struct {
+	incidentRepository repository.IIncident
+	features           feature.IFeature
+	dbHealth           health.IHealth
+	startedAt          time.Time
+}
+
+func NewStatusUsecase(incidentRepository repository.IIncident, features feature.IFeature, dbHealth health.IHealth, startedAt time.Time) IStatusUsecase {
+	return &StatusUsecase{incidentRepository: incidentRepository, features: features, dbHealth: dbHealth, startedAt: startedAt}
+}
+
+// GetStatus still answers when the database is down; incidents are then left
+// out rather than failing the whole page.
+func (statusUsecase *StatusUsecase) GetStatus(ctx context.Context) dto.Res {
+	var res dto.Res
+
+	status := dto.Status{
+		Status:        dto.StatusOperational,
+		Components:    []dto.ComponentStatus{{Name: "api", Status: dto.StatusOperational}},
+		Incidents:     []model.Incident{},
+		StartedAt:     statusUsecase.startedAt,
+		UptimeSeconds: int64(time.Since(statusUsecase.startedAt).Seconds()),
+	}
+
+	if statusUsecase.dbHealth.Healthy() {
+		status.Components = append(status.Components, dto.ComponentStatus{Name: "database", Status: dto.StatusOperational})
+
+		incidents, err := statusUsecase.incidentRepository.GetIncidentsSince(ctx, time.Now().Add(-incidentWindow))
+		if err != nil {
+			logger.GetLogger().WithField("error", err).Error("Error while get incidents")
+		} else {
+			status.Incidents = incidents
+		}
+	} else {
+		status.Components = append(status.Components, dto.ComponentStatus{Name: "database", Status: dto.StatusMajorOutage})
+		status.Status = dto.StatusMajorOutage
+	}
+
+	for _, name := range statusComponents {
+		component := dto.ComponentStatus{Name: name, Status: dto.StatusOperational}
+		if !statusUsecase.features.Enabled(name) {
+			component.Status = dto.StatusDegraded
+			status.Status = worseStatus(status.Status, dto.StatusDegraded)
+		}
+		status.Components = append(status.Components, component)
+	}
+
+	for _, incident := range status.Incidents {
+		if incident.ResolvedAt != nil {
+			continue
+		}
+		if incident.Severity == model.IncidentMajor {
+			status.Status = worseStatus(status.Status, dto.StatusMajorOutage)
+		} else {
+			status.Status = worseStatus(status.Status, dto.StatusDegraded)
+		}
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = status
+	return res
+}
+
+func (statusUsecase *StatusUsecase) CreateIncident(ctx context.Context, req model.ReqIncident) dto.Res {
+	var res dto.Res
+
+	incident := model.Incident{Title: req.Title, Message: req.Message, Severity: req.Severity}
+	if incident.Severity == "" {
+		incident.Severity = model.IncidentMinor
+	}
+
+	id, err := statusUsecase.incidentRepository.CreateIncident(ctx, incident)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while create incident")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	incident.ID = id
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = incident
+	return res
+}
+
+func (statusUsecase *StatusUsecase) ResolveIncident(ctx context.Context, id int64) dto.Res {
+	var res dto.Res
+
+	err := statusUsecase.incidentRepository.ResolveIncident(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Open incident not found."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("incident_id", id).Error("Error while resolve incident")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+var statusRank = map[string]int{dto.StatusOperational: 0, dto.StatusDegraded: 1, dto.StatusMajorOutage: 2}
+
+func worseStatus(current string, candidate string) string {
+	if statusRank[candidate] > statusRank[current] {
+		return candidate
+	}
+	return current
+}
@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/infrastructure/feature"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func allFeatures(enabled bool) *feature.Registry {
+	features := feature.NewRegistry()
+	for _, name := range []string{feature.Cache, feature.PubSub, feature.ServiceBus, feature.TulusTech} {
+		features.Set(name, enabled)
+	}
+	return features
+}
+
+func TestStatusUsecase_GetStatusOperational(t *testing.T) {
+	resolvedAt := time.Now().Add(-time.Hour)
+	incidentRepository := &repomocks.IIncident{}
+	incidentRepository.On("GetIncidentsSince", context.Background(), mock.AnythingOfType("time.Time")).
+		Return([]model.Incident{{ID: 1, Title: "Slow logins", Severity: model.IncidentMajor, ResolvedAt: &resolvedAt}}, nil).Once()
+
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, allFeatures(true), stubHealth(true), time.Now().Add(-time.Minute))
+	response := statusUsecase.GetStatus(context.Background())
+
+	status := response.Data.(dto.Status)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, dto.StatusOperational, status.Status)
+	assert.Len(t, status.Incidents, 1)
+	assert.GreaterOrEqual(t, status.UptimeSeconds, int64(60))
+}
+
+func TestStatusUsecase_GetStatusOpenMajorIncident(t *testing.T) {
+	incidentRepository := &repomocks.IIncident{}
+	incidentRepository.On("GetIncidentsSince", context.Background(), mock.AnythingOfType("time.Time")).
+		Return([]model.Incident{{ID: 2, Title: "Login errors", Severity: model.IncidentMajor}}, nil).Once()
+
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, allFeatures(false), stubHealth(true), time.Now())
+	response := statusUsecase.GetStatus(context.Background())
+
+	assert.Equal(t, dto.StatusMajorOutage, response.Data.(dto.Status).Status)
+}
+
+func TestStatusUsecase_GetStatusDatabaseDown(t *testing.T) {
+	incidentRepository := &repomocks.IIncident{}
+
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, allFeatures(true), stubHealth(false), time.Now())
+	response := statusUsecase.GetStatus(context.Background())
+
+	status := response.Data.(dto.Status)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, dto.StatusMajorOutage, status.Status)
+	assert.Empty(t, status.Incidents)
+	incidentRepository.AssertNotCalled(t, "GetIncidentsSince", mock.Anything, mock.Anything)
+}
+
+func TestStatusUsecase_GetStatusIncidentsUnavailable(t *testing.T) {
+	incidentRepository := &repomocks.IIncident{}
+	incidentRepository.On("GetIncidentsSince", context.Background(), mock.AnythingOfType("time.Time")).
+		Return([]model.Incident{}, errors.New("timeout")).Once()
+
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, allFeatures(true), stubHealth(true), time.Now())
+	response := statusUsecase.GetStatus(context.Background())
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, dto.StatusOperational, response.Data.(dto.Status).Status)
+}
+
+func TestStatusUsecase_CreateIncidentDefaultsToMinor(t *testing.T) {
+	incidentRepository := &repomocks.IIncident{}
+	incidentRepository.On("CreateIncident", context.Background(), model.Incident{Title: "Search is slow", Severity: model.IncidentMinor}).Return(int64(4), nil).Once()
+
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, allFeatures(true), stubHealth(true), time.Now())
+	response := statusUsecase.CreateIncident(context.Background(), model.ReqIncident{Title: "Search is slow"})
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, int64(4), response.Data.(model.Incident).ID)
+}
+
+func TestStatusUsecase_ResolveIncidentNotOpen(t *testing.T) {
+	incidentRepository := &repomocks.IIncident{}
+	incidentRepository.On("ResolveIncident", context.Background(), int64(4)).Return(sql.ErrNoRows).Once()
+
+	statusUsecase := usecase.NewStatusUsecase(incidentRepository, allFeatures(true), stubHealth(true), time.Now())
+	response := statusUsecase.ResolveIncident(context.Background(), 4)
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
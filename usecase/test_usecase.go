@@ -3,15 +3,19 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"my-project/domain/dto"
 	"my-project/infrastructure/cache"
 	tulushost "my-project/infrastructure/clients/tulustech"
 	"my-project/infrastructure/clients/tulustech/models"
+	"my-project/infrastructure/feature"
 	"my-project/infrastructure/logger"
 	"my-project/infrastructure/pubsub"
 	"my-project/infrastructure/servicebus"
 )
 
+const disabled = "Disabled"
+
 type ITestUsecase interface {
 	Test(ctx context.Context) dto.TestDto
 }
@@ -44,30 +48,39 @@ func (testUsecase *TestUsecase) Test(ctx context.Context) dto.TestDto {
 		return res
 	}
 	publishResponse, err := testUsecase.TestPubSub.Publish(ctx, "topic", byteMsg)
-	if err != nil {
+	if errors.Is(err, feature.ErrDisabled) {
+		res.PubSub = disabled
+	} else if err != nil {
 		logger.GetLogger().Error("Error while publishing message")
 		res.PubSub = err.Error()
 		//return res
+	} else {
+		logger.GetLogger().WithField("publishResponse", publishResponse).Info("Successfully published")
+		res.PubSub = "OK"
 	}
-	logger.GetLogger().WithField("publishResponse", publishResponse).Info("Successfully published")
-	res.PubSub = "OK"
 
 	err = testUsecase.TestServiceBus.SendMessage(byteMsg)
-	if err != nil {
+	if errors.Is(err, feature.ErrDisabled) {
+		res.ServiceBus = disabled
+	} else if err != nil {
 		logger.GetLogger().Error("Error while publishing message with service bus")
 		res.ServiceBus = err.Error()
 		//return res
+	} else {
+		res.ServiceBus = "OK"
 	}
-	res.ServiceBus = "OK"
 
 	testUsecase.TestCache.Set(ctx, "test", "test")
 	val, err := testUsecase.TestCache.Get(ctx, "test")
-	if err != nil {
+	if errors.Is(err, feature.ErrDisabled) {
+		res.Cache = disabled
+	} else if err != nil {
 		logger.GetLogger().Error("Error while getting value from cache")
-		res.ServiceBus = "Error while getting value from cache"
+		res.Cache = "Error while getting value from cache"
 		//return res
+	} else {
+		res.Cache, _ = val.(string)
 	}
-	res.Cache = val.(string)
 
 	reqHeader := models.ReqHeader{}
 	randomTypingRes, err := testUsecase.TulusTechHost.GetRandomTyping(ctx, reqHeader)
@@ -75,9 +88,10 @@ func (testUsecase *TestUsecase) Test(ctx context.Context) dto.TestDto {
 		logger.GetLogger().Error("Error while get random typing")
 		res.TulusTech = err.Error()
 		//return res
+	} else {
+		logger.GetLogger().WithField("randomTypingResponse", randomTypingRes).Info("Successfully get random typing")
+		res.TulusTech = "OK"
 	}
-	logger.GetLogger().WithField("randomTypingResponse", randomTypingRes).Info("Successfully get random typing")
-	res.TulusTech = "OK"
 
 	return res
 }
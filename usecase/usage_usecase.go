@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"my-project/domain/dto"
+	"my-project/domain/repository"
+	"my-project/infrastructure/cache"
+	"my-project/infrastructure/logger"
+	"sort"
+	"time"
+)
+
+// maxUsageDays bounds the window the usage endpoints report on.
+const maxUsageDays = 90
+
+type IUsageUsecase interface {
+	GetMyUsage(ctx context.Context, userId int64, days int) dto.Res
+	GetUsageBreakdown(ctx context.Context, days int) dto.Res
+	Flush(ctx context.Context)
+}
+
+type UsageUsecase struct {
+	usageRepository repository.IUsage
+	usageCounter    cache.IUsageCounter
+}
+
+func NewUsageUsecase(usageRepository repository.IUsage, usageCounter cache.IUsageCounter) IUsageUsecase {
+	return &UsageUsecase{usageRepository: usageRepository, usageCounter: usageCounter}
+}
+
+func usageSince(days int) time.Time {
+	return time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+}
+
+func invalidUsageDays() dto.Res {
+	return dto.Res{ResponseCode: "400", ResponseMessage: fmt.Sprintf("Days must be between 1 and %d.", maxUsageDays)}
+}
+
+func (usageUsecase *UsageUsecase) GetMyUsage(ctx context.Context, userId int64, days int) dto.Res {
+	var res dto.Res
+
+	if days < 1 || days > maxUsageDays {
+		return invalidUsageDays()
+	}
+
+	since := usageSince(days)
+	usages, err := usageUsecase.usageRepository.GetUsageByUserId(ctx, userId, since)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get usage")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	usage := dto.UserUsage{UserID: userId, Since: since, Endpoints: make(map[string]int64), Days: []dto.DailyUsage{}}
+	for _, row := range usages {
+		usage.Total += row.Count
+		usage.Endpoints[row.Endpoint] += row.Count
+
+		day := row.Day.Format("2006-01-02")
+		if n := len(usage.Days); n > 0 && usage.Days[n-1].Day == day {
+			usage.Days[n-1].Count += row.Count
+		} else {
+			usage.Days = append(usage.Days, dto.DailyUsage{Day: day, Count: row.Count})
+		}
+	}
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = usage
+	return res
+}
+
+// GetUsageBreakdown lists every user with requests in the window, heaviest
+// users first.
+func (usageUsecase *UsageUsecase) GetUsageBreakdown(ctx context.Context, days int) dto.Res {
+	var res dto.Res
+
+	if days < 1 || days > maxUsageDays {
+		return invalidUsageDays()
+	}
+
+	since := usageSince(days)
+	totals, err := usageUsecase.usageRepository.GetUsageTotals(ctx, since)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get usage totals")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	byUser := make(map[int64]*dto.UserUsage)
+	users := []dto.UserUsage{}
+	for _, row := range totals {
+		usage, ok := byUser[row.UserID]
+		if !ok {
+			usage = &dto.UserUsage{UserID: row.UserID, Since: since, Endpoints: make(map[string]int64)}
+			byUser[row.UserID] = usage
+		}
+		usage.Total += row.Count
+		usage.Endpoints[row.Endpoint] += row.Count
+	}
+	for _, usage := range byUser {
+		users = append(users, *usage)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Total != users[j].Total {
+			return users[i].Total > users[j].Total
+		}
+		return users[i].UserID < users[j].UserID
+	})
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	res.Data = users
+	return res
+}
+
+// Flush moves the cached counters into the database. Counts that cannot be
+// stored are put back so the next flush retries them.
+func (usageUsecase *UsageUsecase) Flush(ctx context.Context) {
+	usages, err := usageUsecase.usageCounter.Drain(ctx)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while drain usage counters")
+	}
+	if len(usages) == 0 {
+		return
+	}
+
+	if err := usageUsecase.usageRepository.AddUsage(ctx, usages); err != nil {
+		logger.GetLogger().WithField("error", err).WithField("counters", len(usages)).Error("Error while flush usage, keeping counters for the next flush")
+		for _, usage := range usages {
+			usageUsecase.usageCounter.Add(ctx, usage)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"my-project/domain/dto"
+	"my-project/domain/model"
+	"my-project/infrastructure/cache"
+	"my-project/mocks/repomocks"
+	"my-project/usecase"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageUsecase_GetMyUsage(t *testing.T) {
+	day1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	usageRepository := &repomocks.IUsage{}
+	usageRepository.On("GetUsageByUserId", context.Background(), int64(1), mock.AnythingOfType("time.Time")).Return([]model.APIUsage{
+		{UserID: 1, Day: day1, Endpoint: "GET /api/bookmarks", Count: 3},
+		{UserID: 1, Day: day1, Endpoint: "GET /api/me/sessions", Count: 1},
+		{UserID: 1, Day: day2, Endpoint: "GET /api/bookmarks", Count: 2},
+	}, nil).Once()
+
+	usageUsecase := usecase.NewUsageUsecase(usageRepository, cache.NewUsageCounter(nil))
+	response := usageUsecase.GetMyUsage(context.Background(), 1, 30)
+
+	usage := response.Data.(dto.UserUsage)
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.Equal(t, int64(6), usage.Total)
+	assert.Equal(t, map[string]int64{"GET /api/bookmarks": 5, "GET /api/me/sessions": 1}, usage.Endpoints)
+	assert.Equal(t, []dto.DailyUsage{{Day: "2024-03-01", Count: 4}, {Day: "2024-03-02", Count: 2}}, usage.Days)
+}
+
+func TestUsageUsecase_GetMyUsageInvalidDays(t *testing.T) {
+	usageUsecase := usecase.NewUsageUsecase(&repomocks.IUsage{}, cache.NewUsageCounter(nil))
+
+	assert.Equal(t, "400", usageUsecase.GetMyUsage(context.Background(), 1, 0).ResponseCode)
+	assert.Equal(t, "400", usageUsecase.GetMyUsage(context.Background(), 1, 91).ResponseCode)
+}
+
+func TestUsageUsecase_GetUsageBreakdown(t *testing.T) {
+	usageRepository := &repomocks.IUsage{}
+	usageRepository.On("GetUsageTotals", context.Background(), mock.AnythingOfType("time.Time")).Return([]model.APIUsage{
+		{UserID: 1, Endpoint: "GET /api/bookmarks", Count: 2},
+		{UserID: 2, Endpoint: "GET /api/bookmarks", Count: 4},
+		{UserID: 2, Endpoint: "POST /api/searches/history", Count: 1},
+	}, nil).Once()
+
+	usageUsecase := usecase.NewUsageUsecase(usageRepository, cache.NewUsageCounter(nil))
+	response := usageUsecase.GetUsageBreakdown(context.Background(), 7)
+
+	users := response.Data.([]dto.UserUsage)
+	require.Len(t, users, 2)
+	assert.Equal(t, int64(2), users[0].UserID)
+	assert.Equal(t, int64(5), users[0].Total)
+	assert.Equal(t, int64(1), users[1].UserID)
+}
+
+func TestUsageUsecase_FlushKeepsCountersOnError(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	usage := model.APIUsage{UserID: 1, Day: day, Endpoint: "GET /api/bookmarks", Count: 3}
+	usageCounter := cache.NewUsageCounter(nil)
+	usageCounter.Add(context.Background(), usage)
+
+	usageRepository := &repomocks.IUsage{}
+	usageRepository.On("AddUsage", context.Background(), []model.APIUsage{usage}).Return(errors.New("database is down")).Once()
+
+	usecase.NewUsageUsecase(usageRepository, usageCounter).Flush(context.Background())
+
+	remaining, err := usageCounter.Drain(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.APIUsage{usage}, remaining)
+}
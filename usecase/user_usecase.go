@@ -3,27 +3,51 @@ package usecase
 import (
 	"context"
 	"crypto/md5"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"my-project/domain/dto"
 	"my-project/domain/model"
 	"my-project/domain/repository"
 	"my-project/infrastructure/configuration"
 	"my-project/infrastructure/logger"
+	"my-project/infrastructure/mailer"
 	"my-project/infrastructure/utils"
+	"strings"
 	"time"
 )
 
+var (
+	ErrEmailRequired         = errors.New("email_required")
+	ErrEmailDomainNotAllowed = errors.New("email_domain_not_allowed")
+)
+
 type IUserUsecase interface {
 	Login(ctx context.Context, req model.ReqLogin) dto.ResLogin
 	Register(ctx context.Context, req model.ReqRegister) dto.ResRegister
+	LoginWithIdentity(ctx context.Context, identity model.OIDCIdentity, client model.SessionClient) dto.ResLogin
+	LinkIdentity(ctx context.Context, userId int64, identity model.OIDCIdentity) dto.Res
+	VerifyEmail(ctx context.Context, token string) dto.Res
 }
 
 type UserUsecase struct {
-	userRepository repository.IUser
+	userRepository              repository.IUser
+	sessionRepository           repository.ISession
+	identityRepository          repository.IIdentity
+	emailVerificationRepository repository.IEmailVerification
+	mailer                      mailer.IMailer
 }
 
-func NewUserUsecase(userRepository repository.IUser) IUserUsecase {
-	return &UserUsecase{userRepository: userRepository}
+func NewUserUsecase(userRepository repository.IUser, sessionRepository repository.ISession, identityRepository repository.IIdentity, emailVerificationRepository repository.IEmailVerification, mailer mailer.IMailer) IUserUsecase {
+	return &UserUsecase{
+		userRepository:              userRepository,
+		sessionRepository:           sessionRepository,
+		identityRepository:          identityRepository,
+		emailVerificationRepository: emailVerificationRepository,
+		mailer:                      mailer,
+	}
 }
 
 func (userUsecase *UserUsecase) Login(ctx context.Context, req model.ReqLogin) dto.ResLogin {
@@ -44,16 +68,151 @@ func (userUsecase *UserUsecase) Login(ctx context.Context, req model.ReqLogin) d
 		res.ResponseMessage = "Unautorized."
 		return res
 	}
+	if !isActive(user, &res.Res) {
+		return res
+	}
+
+	return userUsecase.startSession(ctx, user, req.Client)
+}
+
+// LoginWithIdentity signs in a user authenticated by an OIDC provider. The
+// account is found by provider and subject, never by email: an email claim is
+// only as trustworthy as the provider's tenant admin. A first sign-in creates
+// a new account without a password so it can only sign in through OIDC. It
+// is refused when a verified email already belongs to another account; its
+// owner signs in and links the provider with LinkIdentity instead.
+func (userUsecase *UserUsecase) LoginWithIdentity(ctx context.Context, identity model.OIDCIdentity, client model.SessionClient) dto.ResLogin {
+	var res dto.ResLogin
+
+	user, err := userUsecase.identityRepository.GetUserByIdentity(ctx, identity.Provider, identity.Subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		user, err = userUsecase.createIdentityUser(ctx, identity, &res.Res)
+		if res.ResponseCode != "" {
+			return res
+		}
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while Getting user by identity")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	if !isActive(user, &res.Res) {
+		return res
+	}
+
+	logger.GetLogger().WithField("provider", identity.Provider).WithField("user_id", user.ID).Info("OIDC login")
+	return userUsecase.startSession(ctx, user, client)
+}
+
+// createIdentityUser fills res when the account may not be created. The
+// email is only stored, and only used for the domain lists, when the provider
+// has verified it.
+func (userUsecase *UserUsecase) createIdentityUser(ctx context.Context, identity model.OIDCIdentity, res *dto.Res) (model.User, error) {
+	email := ""
+	if identity.EmailVerified {
+		email = identity.Email
+	}
+
+	if email != "" {
+		_, err := userUsecase.userRepository.GetByEmail(ctx, email)
+		if err == nil {
+			res.ResponseCode = "409"
+			res.ResponseMessage = "An account with this email already exists. Sign in and link this provider to it."
+			return model.User{}, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return model.User{}, err
+		}
+	}
+
+	status, err := registrationStatus(email)
+	if err != nil {
+		refuseRegistration(err, res)
+		return model.User{}, nil
+	}
+	name := identity.Name
+	if name == "" {
+		name = identity.Provider
+	}
+	id, err := userUsecase.identityRepository.CreateUserWithIdentity(ctx, model.User{
+		Name:     name,
+		UserName: identity.Provider + ":" + identity.Subject,
+		Email:    email,
+		Status:   status,
+	}, identity.Provider, identity.Subject)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return userUsecase.userRepository.GetById(ctx, int(id))
+}
+
+// LinkIdentity adds a provider identity to the signed-in account, so existing
+// accounts can sign in through OIDC afterwards.
+func (userUsecase *UserUsecase) LinkIdentity(ctx context.Context, userId int64, identity model.OIDCIdentity) dto.Res {
+	var res dto.Res
+
+	err := userUsecase.identityRepository.LinkIdentity(ctx, userId, identity.Provider, identity.Subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "409"
+		res.ResponseMessage = "This identity is already linked to an account."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while link identity")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	logger.GetLogger().WithField("provider", identity.Provider).WithField("user_id", userId).Info("OIDC identity linked")
+
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
+	return res
+}
+
+// startSession records the device the user signed in from and issues an
+// access token bound to that session so it can be revoked later.
+func (userUsecase *UserUsecase) startSession(ctx context.Context, user model.User, client model.SessionClient) dto.ResLogin {
+	var res dto.ResLogin
+
+	sessionId, err := userUsecase.sessionRepository.CreateSession(ctx, model.UserSession{
+		UserID:    user.ID,
+		UserAgent: truncate(client.UserAgent, 512),
+		IPAddress: truncate(client.IPAddress, 64),
+	})
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while create session")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	return issueToken(user, sessionId)
+}
+
+func truncate(value string, max int) string {
+	if len(value) > max {
+		return value[:max]
+	}
+	return value
+}
+
+func issueToken(user model.User, sessionId int64) dto.ResLogin {
+	var res dto.ResLogin
 
 	secretKey := configuration.C.App.SecretKey
 
 	// Create the Claims
-	expiration := time.Now().Add(5 * time.Minute)
+	expiration := time.Now().Add(model.SessionTokenLifetime)
 
 	claims := make(map[string]interface{})
 	claims["user_name"] = user.UserName
 	claims["exp"] = expiration.Unix()
 	claims["is"] = fmt.Sprint(user.ID)
+	claims["sid"] = sessionId
 
 	accessToken, err := utils.GenerateToken(claims, secretKey)
 	if err != nil {
@@ -70,15 +229,33 @@ func (userUsecase *UserUsecase) Login(ctx context.Context, req model.ReqLogin) d
 	return res
 }
 
+// Register creates the account. An email address is not stored until the
+// user confirms it through the link mailed to them; while the domain lists
+// are in use the account stays unverified until then, because an address
+// nobody has confirmed must not decide whether the account is let in.
 func (userUcase *UserUsecase) Register(ctx context.Context, req model.ReqRegister) dto.ResRegister {
 	var res dto.ResRegister
 
+	status, err := registrationStatus(req.Email)
+	if err != nil {
+		refuseRegistration(err, &res.Res)
+		return res
+	}
+
 	reqUser := model.User{
 		Name:     req.Name,
 		UserName: req.UserName,
 		Password: req.Password,
+		Status:   status,
+	}
+	if req.Email == "" {
+		err = userUcase.userRepository.CreateUser(ctx, reqUser)
+	} else {
+		if usesDomainLists() {
+			reqUser.Status = model.UserStatusUnverified
+		}
+		err = userUcase.createWithVerification(ctx, reqUser, req.Email)
 	}
-	err := userUcase.userRepository.CreateUser(ctx, reqUser)
 	if err != nil {
 		res.Data = nil
 		res.ResponseCode = "500"
@@ -92,6 +269,164 @@ func (userUcase *UserUsecase) Register(ctx context.Context, req model.ReqRegiste
 	res.Data = userDto
 	res.ResponseCode = "200"
 	res.ResponseMessage = "Success"
+	switch reqUser.Status {
+	case model.UserStatusUnverified:
+		res.ResponseCode = "202"
+		res.ResponseMessage = "Check your email to confirm your address."
+	case model.UserStatusPending:
+		res.ResponseCode = "202"
+		res.ResponseMessage = "Registration is pending approval."
+	}
+
+	return res
+}
+
+// createWithVerification stores the user together with a pending
+// verification of email and mails the confirmation link.
+func (userUcase *UserUsecase) createWithVerification(ctx context.Context, user model.User, email string) error {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while generate verification token")
+		return err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	_, err := userUcase.emailVerificationRepository.CreateUserWithVerification(ctx, user, model.EmailVerification{
+		Email:     email,
+		TokenHash: model.HashVerificationToken(token),
+		ExpiresAt: time.Now().Add(model.EmailVerificationLifetime),
+	})
+	if err != nil {
+		return err
+	}
+
+	verifyURL := configuration.C.Registration.VerifyEmailURL
+	if userUcase.mailer == nil || verifyURL == "" {
+		logger.GetLogger().WithField("user_name", user.UserName).Warn("Mail or verifyEmailUrl is not configured, verification link not sent")
+		return nil
+	}
+	body := fmt.Sprintf("Confirm your email address by opening this link:\n\n%s?token=%s\n\nThe link expires in %s.\n", verifyURL, token, model.EmailVerificationLifetime)
+	if err := userUcase.mailer.Send(ctx, email, "Confirm your email address", body); err != nil {
+		logger.GetLogger().WithField("error", err).WithField("user_name", user.UserName).Error("Error while send verification email")
+	}
+	return nil
+}
+
+// VerifyEmail stores the address behind a confirmation link on its user. An
+// account that was waiting for it moves on to the status the domain lists
+// give the now verified address.
+func (userUcase *UserUsecase) VerifyEmail(ctx context.Context, token string) dto.Res {
+	var res dto.Res
+
+	verification, err := userUcase.emailVerificationRepository.GetVerificationByHash(ctx, model.HashVerificationToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		res.ResponseCode = "404"
+		res.ResponseMessage = "Verification link is invalid or has expired."
+		return res
+	}
+	if err != nil {
+		logger.GetLogger().WithField("error", err).Error("Error while get email verification")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	_, err = userUcase.userRepository.GetByEmail(ctx, verification.Email)
+	if err == nil {
+		res.ResponseCode = "409"
+		res.ResponseMessage = "Email address is already in use."
+		return res
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		logger.GetLogger().WithField("error", err).Error("Error while Getting user by email")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+
+	status, err := registrationStatus(verification.Email)
+	if err != nil {
+		refuseRegistration(err, &res)
+		return res
+	}
+
+	err = userUcase.emailVerificationRepository.ConfirmEmail(ctx, verification)
+	if err != nil {
+		logger.GetLogger().WithField("error", err).WithField("user_id", verification.UserID).Error("Error while confirm email")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
+	err = userUcase.userRepository.UpdateStatus(ctx, verification.UserID, model.UserStatusUnverified, status)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.GetLogger().WithField("error", err).WithField("user_id", verification.UserID).Error("Error while update user status")
+		res.ResponseCode = "500"
+		res.ResponseMessage = "Internal server error"
+		return res
+	}
 
+	res.ResponseCode = "200"
+	res.ResponseMessage = "Success"
 	return res
 }
+
+func usesDomainLists() bool {
+	registration := configuration.C.Registration
+	return len(registration.AllowedDomains) > 0 || len(registration.DeniedDomains) > 0
+}
+
+// registrationStatus applies the configured email domain lists and returns
+// the status a new account starts in. The email must have been verified for
+// the result to grant anything.
+func registrationStatus(email string) (string, error) {
+	registration := configuration.C.Registration
+
+	if usesDomainLists() {
+		at := strings.LastIndex(email, "@")
+		if at < 0 {
+			return "", ErrEmailRequired
+		}
+		domain := strings.ToLower(email[at+1:])
+		if containsDomain(registration.DeniedDomains, domain) {
+			return "", ErrEmailDomainNotAllowed
+		}
+		if len(registration.AllowedDomains) > 0 && !containsDomain(registration.AllowedDomains, domain) {
+			return "", ErrEmailDomainNotAllowed
+		}
+	}
+
+	if registration.RequireApproval {
+		return model.UserStatusPending, nil
+	}
+	return model.UserStatusActive, nil
+}
+
+func containsDomain(domains []string, domain string) bool {
+	for _, d := range domains {
+		if strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func refuseRegistration(err error, res *dto.Res) {
+	switch {
+	case errors.Is(err, ErrEmailRequired):
+		res.ResponseCode = "400"
+		res.ResponseMessage = "Email is required to register."
+	default:
+		res.ResponseCode = "403"
+		res.ResponseMessage = "Email domain is not allowed to register."
+	}
+}
+
+// isActive fills res and returns false when the account may not log in yet.
+func isActive(user model.User, res *dto.Res) bool {
+	if reason := user.InactiveReason(); reason != "" {
+		res.ResponseCode = "403"
+		res.ResponseMessage = reason
+		return false
+	}
+	return true
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/md5"
 	"database/sql"
+	"errors"
 	"fmt"
 	"my-project/domain/model"
+	"my-project/infrastructure/configuration"
 	"my-project/mocks/repomocks"
 	"my-project/usecase"
 	"testing"
@@ -17,9 +19,10 @@ import (
 
 func TestUserUsecase_RegisterSuccess(t *testing.T) {
 	userRepository := &repomocks.IUser{}
+	sessionRepository := &repomocks.ISession{}
 	userRepository.On("CreateUser", context.Background(), mock.AnythingOfType("model.User")).Return(nil).Once()
 
-	userUsecase := usecase.NewUserUsecase(userRepository)
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
 	response := userUsecase.Register(context.Background(), model.ReqRegister{
 		Name:     "Lambok Tulus Simamora",
 		UserName: "lamboktulus1379",
@@ -32,9 +35,10 @@ func TestUserUsecase_RegisterSuccess(t *testing.T) {
 
 func TestUserUsecase_RegisterError(t *testing.T) {
 	userRepository := &repomocks.IUser{}
+	sessionRepository := &repomocks.ISession{}
 	userRepository.On("CreateUser", context.Background(), mock.AnythingOfType("model.User")).Return(sql.ErrNoRows).Once()
 
-	userUsecase := usecase.NewUserUsecase(userRepository)
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
 	response := userUsecase.Register(context.Background(), model.ReqRegister{
 		Name:     "Lambok Tulus Simamora",
 		UserName: "lamboktulus1379",
@@ -47,19 +51,23 @@ func TestUserUsecase_RegisterError(t *testing.T) {
 
 func TestUserUsecase_LoginSuccess(t *testing.T) {
 	userRepository := &repomocks.IUser{}
+	sessionRepository := &repomocks.ISession{}
 	md5Req := fmt.Sprintf("%x", md5.Sum([]byte("MyPassword_123")))
 	userRepository.On("GetByUserName", context.Background(), mock.Anything).Return(model.User{
 		ID:        1,
 		Name:      "Lambok Tulus Simamora",
 		UserName:  "lamboktulus1379",
 		Password:  md5Req,
+		Status:    model.UserStatusActive,
 		CreatedAt: time.Now(),
 		CreatedBy: 0,
 		UpdatedAt: time.Now(),
 		UpdatedBy: 0,
 	}, nil).Once()
 
-	userUsecase := usecase.NewUserUsecase(userRepository)
+	sessionRepository.On("CreateSession", context.Background(), mock.AnythingOfType("model.UserSession")).Return(int64(7), nil).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
 
 	response := userUsecase.Login(context.Background(), model.ReqLogin{
 		UserName: "lamboktulus1379",
@@ -72,9 +80,10 @@ func TestUserUsecase_LoginSuccess(t *testing.T) {
 
 func TestUserUsecase_LoginUserNotFound(t *testing.T) {
 	userRepository := &repomocks.IUser{}
+	sessionRepository := &repomocks.ISession{}
 	userRepository.On("GetByUserName", context.Background(), mock.Anything).Return(model.User{}, sql.ErrNoRows).Once()
 
-	userUsecase := usecase.NewUserUsecase(userRepository)
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
 
 	md5Req := fmt.Sprintf("%x", md5.Sum([]byte("MyPassword_123")))
 	response := userUsecase.Login(context.Background(), model.ReqLogin{
@@ -88,6 +97,7 @@ func TestUserUsecase_LoginUserNotFound(t *testing.T) {
 
 func TestUserUsecase_LoginUserWrongPassword(t *testing.T) {
 	userRepository := &repomocks.IUser{}
+	sessionRepository := &repomocks.ISession{}
 	md5Req := fmt.Sprintf("%x", md5.Sum([]byte("MyPassword_123")))
 	userRepository.On("GetByUserName", context.Background(), mock.Anything).Return(model.User{
 		ID:        1,
@@ -100,7 +110,7 @@ func TestUserUsecase_LoginUserWrongPassword(t *testing.T) {
 		UpdatedBy: 0,
 	}, nil).Once()
 
-	userUsecase := usecase.NewUserUsecase(userRepository)
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
 
 	response := userUsecase.Login(context.Background(), model.ReqLogin{
 		UserName: "lamboktulus1379",
@@ -110,3 +120,236 @@ func TestUserUsecase_LoginUserWrongPassword(t *testing.T) {
 	assert.NotNil(t, response)
 	assert.Equal(t, "401", response.ResponseCode)
 }
+
+func TestUserUsecase_LoginWithIdentityKnownSubject(t *testing.T) {
+	userRepository := repomocks.NewIUser(t)
+	sessionRepository := repomocks.NewISession(t)
+	identityRepository := repomocks.NewIIdentity(t)
+	identityRepository.On("GetUserByIdentity", context.Background(), "google", "1234").Return(model.User{
+		ID:       1,
+		Name:     "Lambok Tulus Simamora",
+		UserName: "lamboktulus1379",
+		Email:    "tulus@example.com",
+		Status:   model.UserStatusActive,
+	}, nil).Once()
+
+	sessionRepository.On("CreateSession", context.Background(), mock.AnythingOfType("model.UserSession")).Return(int64(7), nil).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, identityRepository, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.LoginWithIdentity(context.Background(), model.OIDCIdentity{
+		Provider: "google",
+		Subject:  "1234",
+		Email:    "tulus@example.com",
+	}, model.SessionClient{UserAgent: "Mozilla/5.0", IPAddress: "127.0.0.1"})
+
+	assert.Equal(t, "200", response.ResponseCode)
+	assert.NotEmpty(t, response.Data.AccessToken)
+}
+
+func TestUserUsecase_LoginWithIdentityDoesNotLinkByEmail(t *testing.T) {
+	userRepository := repomocks.NewIUser(t)
+	identityRepository := repomocks.NewIIdentity(t)
+	identityRepository.On("GetUserByIdentity", context.Background(), "google", "1234").Return(model.User{}, sql.ErrNoRows).Once()
+	userRepository.On("GetByEmail", context.Background(), "tulus@example.com").Return(model.User{ID: 1, Email: "tulus@example.com"}, nil).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, repomocks.NewISession(t), identityRepository, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.LoginWithIdentity(context.Background(), model.OIDCIdentity{
+		Provider:      "google",
+		Subject:       "1234",
+		Email:         "tulus@example.com",
+		EmailVerified: true,
+	}, model.SessionClient{})
+
+	assert.Equal(t, "409", response.ResponseCode)
+}
+
+func TestUserUsecase_LinkIdentity(t *testing.T) {
+	identityRepository := repomocks.NewIIdentity(t)
+	identityRepository.On("LinkIdentity", context.Background(), int64(1), "google", "1234").Return(nil).Once()
+	identityRepository.On("LinkIdentity", context.Background(), int64(2), "google", "1234").Return(sql.ErrNoRows).Once()
+
+	userUsecase := usecase.NewUserUsecase(repomocks.NewIUser(t), repomocks.NewISession(t), identityRepository, &repomocks.IEmailVerification{}, nil)
+	identity := model.OIDCIdentity{Provider: "google", Subject: "1234"}
+
+	assert.Equal(t, "200", userUsecase.LinkIdentity(context.Background(), 1, identity).ResponseCode)
+	assert.Equal(t, "409", userUsecase.LinkIdentity(context.Background(), 2, identity).ResponseCode, "a subject belongs to one account")
+}
+
+func TestUserUsecase_LoginWithIdentityCreatesUser(t *testing.T) {
+	userRepository := repomocks.NewIUser(t)
+	sessionRepository := repomocks.NewISession(t)
+	identityRepository := repomocks.NewIIdentity(t)
+	identityRepository.On("GetUserByIdentity", context.Background(), "microsoft", "abcd").Return(model.User{}, sql.ErrNoRows).Once()
+	// The email is not verified, so it is neither looked up nor stored.
+	identityRepository.On("CreateUserWithIdentity", context.Background(), model.User{
+		Name:     "New User",
+		UserName: "microsoft:abcd",
+		Status:   model.UserStatusActive,
+	}, "microsoft", "abcd").Return(int64(2), nil).Once()
+	userRepository.On("GetById", context.Background(), 2).Return(model.User{
+		ID:       2,
+		Name:     "New User",
+		UserName: "microsoft:abcd",
+		Status:   model.UserStatusActive,
+	}, nil).Once()
+
+	sessionRepository.On("CreateSession", context.Background(), mock.AnythingOfType("model.UserSession")).Return(int64(7), nil).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, identityRepository, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.LoginWithIdentity(context.Background(), model.OIDCIdentity{
+		Provider: "microsoft",
+		Subject:  "abcd",
+		Email:    "victim@example.com",
+		Name:     "New User",
+	}, model.SessionClient{})
+
+	assert.Equal(t, "200", response.ResponseCode)
+}
+
+func TestUserUsecase_LoginWithIdentityUnverifiedEmailNotAllowed(t *testing.T) {
+	configuration.C.Registration = configuration.Registration{AllowedDomains: []string{"tulus.tech"}}
+	defer func() { configuration.C.Registration = configuration.Registration{} }()
+
+	identityRepository := repomocks.NewIIdentity(t)
+	identityRepository.On("GetUserByIdentity", context.Background(), "microsoft", "abcd").Return(model.User{}, sql.ErrNoRows).Once()
+
+	userUsecase := usecase.NewUserUsecase(repomocks.NewIUser(t), repomocks.NewISession(t), identityRepository, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.LoginWithIdentity(context.Background(), model.OIDCIdentity{
+		Provider: "microsoft",
+		Subject:  "abcd",
+		Email:    "lambok@tulus.tech",
+	}, model.SessionClient{})
+
+	assert.Equal(t, "400", response.ResponseCode)
+}
+
+func TestUserUsecase_LoginWithIdentityCreateError(t *testing.T) {
+	identityRepository := repomocks.NewIIdentity(t)
+	identityRepository.On("GetUserByIdentity", context.Background(), "google", "1234").Return(model.User{}, sql.ErrNoRows).Once()
+	identityRepository.On("CreateUserWithIdentity", context.Background(), mock.AnythingOfType("model.User"), "google", "1234").Return(int64(0), errors.New("duplicate")).Once()
+
+	userUsecase := usecase.NewUserUsecase(repomocks.NewIUser(t), repomocks.NewISession(t), identityRepository, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.LoginWithIdentity(context.Background(), model.OIDCIdentity{Provider: "google", Subject: "1234"}, model.SessionClient{})
+
+	assert.Equal(t, "500", response.ResponseCode)
+}
+
+func TestUserUsecase_LoginSessionError(t *testing.T) {
+	userRepository := &repomocks.IUser{}
+	sessionRepository := &repomocks.ISession{}
+	md5Req := fmt.Sprintf("%x", md5.Sum([]byte("MyPassword_123")))
+	userRepository.On("GetByUserName", context.Background(), mock.Anything).Return(model.User{
+		ID:       1,
+		UserName: "lamboktulus1379",
+		Password: md5Req,
+		Status:   model.UserStatusActive,
+	}, nil).Once()
+	sessionRepository.On("CreateSession", context.Background(), mock.AnythingOfType("model.UserSession")).Return(int64(0), errors.New("insert failed")).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.Login(context.Background(), model.ReqLogin{
+		UserName: "lamboktulus1379",
+		Password: "MyPassword_123",
+	})
+
+	assert.Equal(t, "500", response.ResponseCode)
+}
+
+func TestUserUsecase_RegisterDomainNotAllowed(t *testing.T) {
+	configuration.C.Registration = configuration.Registration{AllowedDomains: []string{"tulus.tech"}}
+	defer func() { configuration.C.Registration = configuration.Registration{} }()
+
+	userRepository := repomocks.NewIUser(t)
+	sessionRepository := repomocks.NewISession(t)
+
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
+	missing := userUsecase.Register(context.Background(), model.ReqRegister{Name: "Lambok", UserName: "lambok", Password: "x"})
+	denied := userUsecase.Register(context.Background(), model.ReqRegister{Name: "Lambok", UserName: "lambok", Password: "x", Email: "lambok@example.com"})
+
+	assert.Equal(t, "400", missing.ResponseCode)
+	assert.Equal(t, "403", denied.ResponseCode)
+}
+
+type fakeMailer struct {
+	to   string
+	body string
+}
+
+func (m *fakeMailer) Send(ctx context.Context, to string, subject string, body string) error {
+	m.to = to
+	m.body = body
+	return nil
+}
+
+func TestUserUsecase_RegisterWaitsForEmail(t *testing.T) {
+	configuration.C.Registration = configuration.Registration{AllowedDomains: []string{"Tulus.Tech"}, RequireApproval: true, VerifyEmailURL: "https://tulus.tech/verify-email"}
+	defer func() { configuration.C.Registration = configuration.Registration{} }()
+
+	emailVerificationRepository := repomocks.NewIEmailVerification(t)
+	// The typed address is neither stored on the user nor trusted to skip
+	// the unverified state.
+	emailVerificationRepository.On("CreateUserWithVerification", context.Background(), model.User{
+		Name:     "Lambok",
+		UserName: "lambok",
+		Password: "x",
+		Status:   model.UserStatusUnverified,
+	}, mock.MatchedBy(func(verification model.EmailVerification) bool {
+		return verification.Email == "lambok@tulus.tech" && len(verification.TokenHash) == 64
+	})).Return(int64(4), nil).Once()
+	mailer := &fakeMailer{}
+
+	userUsecase := usecase.NewUserUsecase(repomocks.NewIUser(t), repomocks.NewISession(t), repomocks.NewIIdentity(t), emailVerificationRepository, mailer)
+	response := userUsecase.Register(context.Background(), model.ReqRegister{Name: "Lambok", UserName: "lambok", Password: "x", Email: "lambok@tulus.tech"})
+
+	assert.Equal(t, "202", response.ResponseCode)
+	assert.Equal(t, "lambok@tulus.tech", mailer.to)
+	assert.Contains(t, mailer.body, "https://tulus.tech/verify-email?token=")
+}
+
+func TestUserUsecase_VerifyEmailPendingApproval(t *testing.T) {
+	configuration.C.Registration = configuration.Registration{AllowedDomains: []string{"tulus.tech"}, RequireApproval: true}
+	defer func() { configuration.C.Registration = configuration.Registration{} }()
+
+	verification := model.EmailVerification{ID: 1, UserID: 4, Email: "lambok@tulus.tech"}
+	emailVerificationRepository := repomocks.NewIEmailVerification(t)
+	emailVerificationRepository.On("GetVerificationByHash", context.Background(), model.HashVerificationToken("token")).Return(verification, nil).Once()
+	emailVerificationRepository.On("ConfirmEmail", context.Background(), verification).Return(nil).Once()
+	userRepository := repomocks.NewIUser(t)
+	userRepository.On("GetByEmail", context.Background(), "lambok@tulus.tech").Return(model.User{}, sql.ErrNoRows).Once()
+	userRepository.On("UpdateStatus", context.Background(), int64(4), model.UserStatusUnverified, model.UserStatusPending).Return(nil).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, repomocks.NewISession(t), repomocks.NewIIdentity(t), emailVerificationRepository, nil)
+	response := userUsecase.VerifyEmail(context.Background(), "token")
+
+	assert.Equal(t, "200", response.ResponseCode)
+}
+
+func TestUserUsecase_VerifyEmailInvalidToken(t *testing.T) {
+	emailVerificationRepository := repomocks.NewIEmailVerification(t)
+	emailVerificationRepository.On("GetVerificationByHash", context.Background(), mock.Anything).Return(model.EmailVerification{}, sql.ErrNoRows).Once()
+
+	userUsecase := usecase.NewUserUsecase(repomocks.NewIUser(t), repomocks.NewISession(t), repomocks.NewIIdentity(t), emailVerificationRepository, nil)
+	response := userUsecase.VerifyEmail(context.Background(), "expired")
+
+	assert.Equal(t, "404", response.ResponseCode)
+}
+
+func TestUserUsecase_LoginPendingAccount(t *testing.T) {
+	userRepository := repomocks.NewIUser(t)
+	sessionRepository := repomocks.NewISession(t)
+	md5Req := fmt.Sprintf("%x", md5.Sum([]byte("MyPassword_123")))
+	userRepository.On("GetByUserName", context.Background(), "lamboktulus1379").Return(model.User{
+		ID:       1,
+		UserName: "lamboktulus1379",
+		Password: md5Req,
+		Status:   model.UserStatusPending,
+	}, nil).Once()
+
+	userUsecase := usecase.NewUserUsecase(userRepository, sessionRepository, &repomocks.IIdentity{}, &repomocks.IEmailVerification{}, nil)
+	response := userUsecase.Login(context.Background(), model.ReqLogin{
+		UserName: "lamboktulus1379",
+		Password: "MyPassword_123",
+	})
+
+	assert.Equal(t, "403", response.ResponseCode)
+}